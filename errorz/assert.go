@@ -0,0 +1,164 @@
+package errorz
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+)
+
+const (
+	assertionEqual   = "equal"
+	assertionNotNil  = "not-nil"
+	assertionLen     = "len"
+	assertionInRange = "in-range"
+	assertionOneOf   = "one-of"
+	assertionMatches = "matches"
+)
+
+// AssertEqual panics with a wrapped [*AssertionError] if actual is not equal to expected. The format string and
+// arguments describe the invariant, as in [Assertf].
+func AssertEqual[T comparable](expected, actual T, format string, a ...any) {
+	if expected != actual {
+		panicAssertion(assertionEqual, expected, actual,
+			fmt.Sprintf("expected %v, got %v", expected, actual), format, a...)
+	}
+}
+
+// AssertNotNil panics with a wrapped [*AssertionError] if v is nil, including nil pointers, maps, slices, etc. wrapped
+// in a non-nil interface.
+func AssertNotNil(v any, format string, a ...any) {
+	if isNil(v) {
+		panicAssertion(assertionNotNil, "not nil", v, "expected not nil", format, a...)
+	}
+}
+
+// AssertLen panics with a wrapped [*AssertionError] if the length of v is not equal to expected. The value v must be
+// an array, a pointer to an array, a channel, a map, a slice, or a string, otherwise AssertLen panics with a wrapped
+// [*AssertionError] regardless of expected. A nil v is considered to have length zero.
+func AssertLen(v any, expected int, format string, a ...any) {
+	actual, ok := getLen(v)
+	if !ok {
+		panicAssertion(assertionLen, expected, fmt.Sprintf("%T", v),
+			fmt.Sprintf("expected length %v, got unsupported type %T", expected, v), format, a...)
+	}
+
+	if actual != expected {
+		panicAssertion(assertionLen, expected, actual,
+			fmt.Sprintf("expected length %v, got %v", expected, actual), format, a...)
+	}
+}
+
+func getLen(v any) (int, bool) {
+	if v == nil {
+		return 0, true
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len(), true
+	case reflect.Pointer:
+		if rv.Type().Elem().Kind() == reflect.Array {
+			return rv.Len(), true
+		}
+	}
+
+	return 0, false
+}
+
+// AssertInRange panics with a wrapped [*AssertionError] if v is not in the closed interval [minV, maxV].
+func AssertInRange[T cmp.Ordered](v, minV, maxV T, format string, a ...any) {
+	if v < minV || v > maxV {
+		panicAssertion(assertionInRange, []T{minV, maxV}, v,
+			fmt.Sprintf("expected value in range [%v, %v], got %v", minV, maxV, v), format, a...)
+	}
+}
+
+// AssertOneOf panics with a wrapped [*AssertionError] if v is not one of the allowed values.
+func AssertOneOf[T comparable](v T, allowed []T, format string, a ...any) {
+	if !slices.Contains(allowed, v) {
+		panicAssertion(assertionOneOf, allowed, v,
+			fmt.Sprintf("expected one of %v, got %v", allowed, v), format, a...)
+	}
+}
+
+// AssertMatches panics with a wrapped [*AssertionError] if s does not match the regular expression r.
+func AssertMatches(s string, r *regexp.Regexp, format string, a ...any) {
+	if !r.MatchString(s) {
+		panicAssertion(assertionMatches, r.String(), s,
+			fmt.Sprintf("expected value matching %q, got %q", r.String(), s), format, a...)
+	}
+}
+
+func panicAssertion(assertion string, expected, actual any, description string, format string, a ...any) {
+	panic(Wrap(&AssertionError{
+		assertion:   assertion,
+		message:     fmt.Sprintf(format, a...),
+		description: description,
+		expected:    expected,
+		actual:      actual,
+	}))
+}
+
+var (
+	_ error        = (*AssertionError)(nil)
+	_ ErrorName    = (*AssertionError)(nil)
+	_ ErrorDetails = (*AssertionError)(nil)
+)
+
+// AssertionError describes a failed assertion, e.g. by [AssertEqual]. Its details include the assertion kind (e.g.
+// "equal" or "in-range"), and the expected and actual values.
+type AssertionError struct {
+	assertion   string
+	message     string
+	description string
+	expected    any
+	actual      any
+}
+
+// Error implements the error interface.
+func (e *AssertionError) Error() string {
+	if e.message == "" {
+		return e.description
+	}
+
+	return e.message + ": " + e.description
+}
+
+// GetErrorName implements the [ErrorName] interface.
+func (*AssertionError) GetErrorName() string {
+	return "assertion-error"
+}
+
+// GetErrorDetails implements the [ErrorDetails] interface.
+func (e *AssertionError) GetErrorDetails() map[string]any {
+	return map[string]any{
+		"assertion": e.assertion,
+		"expected":  e.expected,
+		"actual":    e.actual,
+	}
+}
+
+// GetAssertion returns the assertion kind, i.e. one of "equal", "not-nil", "len", "in-range", "one-of", or "matches".
+func (e *AssertionError) GetAssertion() string {
+	return e.assertion
+}
+
+// GetMessage returns the message describing the invariant, as formatted from the arguments of the assertion.
+func (e *AssertionError) GetMessage() string {
+	return e.message
+}
+
+// GetExpected returns the expected value. For [AssertInRange] it is a slice containing the bounds, for
+// [AssertOneOf] the allowed values, and for [AssertMatches] the regular expression.
+func (e *AssertionError) GetExpected() any {
+	return e.expected
+}
+
+// GetActual returns the actual value.
+func (e *AssertionError) GetActual() any {
+	return e.actual
+}
@@ -0,0 +1,142 @@
+package errorz_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+func recoverAssertionError(f func()) (err error) {
+	defer func() {
+		err = errorz.MaybeWrapRecover(recover())
+	}()
+
+	f()
+	return nil
+}
+
+func TestAssertEqual(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(func() { errorz.AssertEqual(1, 1, "e") }).ToNot(Panic())
+
+	err := recoverAssertionError(func() { errorz.AssertEqual("a", "b", "bad value for %v", "k") })
+	g.Expect(err).To(MatchError("bad value for k: expected a, got b"))
+	g.Expect(errorz.GetFrames(err)[0].ShortLocation).To(Equal("errorz_test.TestAssertEqual.func2"))
+
+	g.Expect(errorz.GetSummary(err, false)).To(Equal(&errorz.Summary{
+		Name:    "assertion-error",
+		Message: "bad value for k: expected a, got b",
+		Details: map[string]any{
+			"assertion": "equal",
+			"expected":  "a",
+			"actual":    "b",
+		},
+	}))
+
+	aErr, ok := errorz.As[*errorz.AssertionError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(aErr.GetAssertion()).To(Equal("equal"))
+	g.Expect(aErr.GetMessage()).To(Equal("bad value for k"))
+	g.Expect(aErr.GetExpected()).To(Equal("a"))
+	g.Expect(aErr.GetActual()).To(Equal("b"))
+
+	err = recoverAssertionError(func() { errorz.AssertEqual(1, 2, "") })
+	g.Expect(err).To(MatchError("expected 1, got 2"))
+}
+
+func TestAssertNotNil(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(func() { errorz.AssertNotNil(1, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertNotNil(&struct{}{}, "e") }).ToNot(Panic())
+
+	err := recoverAssertionError(func() { errorz.AssertNotNil(nil, "e") })
+	g.Expect(err).To(MatchError("e: expected not nil"))
+
+	var m map[string]string
+	err = recoverAssertionError(func() { errorz.AssertNotNil(m, "e") })
+	g.Expect(err).To(MatchError("e: expected not nil"))
+	g.Expect(errorz.GetSummary(err, false).Details).To(HaveKeyWithValue("assertion", "not-nil"))
+	g.Expect(errorz.GetSummary(err, false).Details).To(HaveKeyWithValue("expected", "not nil"))
+}
+
+func TestAssertLen(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(func() { errorz.AssertLen([]int{1, 2}, 2, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertLen(map[string]int{"a": 1}, 1, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertLen("abc", 3, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertLen(nil, 0, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertLen(&[2]int{}, 2, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertLen((*[2]int)(nil), 2, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertLen(make(chan int), 0, "e") }).ToNot(Panic())
+
+	err := recoverAssertionError(func() { errorz.AssertLen([]string{"a"}, 2, "e") })
+	g.Expect(err).To(MatchError("e: expected length 2, got 1"))
+	g.Expect(errorz.GetSummary(err, false).Details).To(Equal(map[string]any{
+		"assertion": "len",
+		"expected":  2,
+		"actual":    1,
+	}))
+
+	err = recoverAssertionError(func() { errorz.AssertLen(1, 0, "e") })
+	g.Expect(err).To(MatchError("e: expected length 0, got unsupported type int"))
+	g.Expect(errorz.GetSummary(err, false).Details).To(Equal(map[string]any{
+		"assertion": "len",
+		"expected":  0,
+		"actual":    "int",
+	}))
+
+	err = recoverAssertionError(func() { errorz.AssertLen(&[]int{}, 0, "e") })
+	g.Expect(err).To(MatchError("e: expected length 0, got unsupported type *[]int"))
+}
+
+func TestAssertInRange(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(func() { errorz.AssertInRange(1, 1, 3, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertInRange(3, 1, 3, "e") }).ToNot(Panic())
+	g.Expect(func() { errorz.AssertInRange("b", "a", "c", "e") }).ToNot(Panic())
+
+	err := recoverAssertionError(func() { errorz.AssertInRange(0.5, 1, 3, "e") })
+	g.Expect(err).To(MatchError("e: expected value in range [1, 3], got 0.5"))
+	g.Expect(errorz.GetSummary(err, false).Details).To(Equal(map[string]any{
+		"assertion": "in-range",
+		"expected":  []float64{1, 3},
+		"actual":    0.5,
+	}))
+}
+
+func TestAssertOneOf(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(func() { errorz.AssertOneOf("a", []string{"a", "b"}, "e") }).ToNot(Panic())
+
+	err := recoverAssertionError(func() { errorz.AssertOneOf("c", []string{"a", "b"}, "e") })
+	g.Expect(err).To(MatchError("e: expected one of [a b], got c"))
+	g.Expect(errorz.GetSummary(err, false).Details).To(Equal(map[string]any{
+		"assertion": "one-of",
+		"expected":  []string{"a", "b"},
+		"actual":    "c",
+	}))
+}
+
+func TestAssertMatches(t *testing.T) {
+	g := NewWithT(t)
+	r := regexp.MustCompile(`^[a-z]+$`)
+
+	g.Expect(func() { errorz.AssertMatches("abc", r, "e") }).ToNot(Panic())
+
+	err := recoverAssertionError(func() { errorz.AssertMatches("ABC", r, "e: %v", fmt.Sprint(1)) })
+	g.Expect(err).To(MatchError(`e: 1: expected value matching "^[a-z]+$", got "ABC"`))
+	g.Expect(errorz.GetSummary(err, false).Details).To(Equal(map[string]any{
+		"assertion": "matches",
+		"expected":  "^[a-z]+$",
+		"actual":    "ABC",
+	}))
+}
@@ -0,0 +1,143 @@
+package errorz
+
+import (
+	"encoding/json"
+	"io/fs"
+	"path"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// MessageCatalog contains user-facing messages for errors, keyed by language and error name (as in [Summary.Name]).
+// Messages are [text/template] templates executed with the error details (as in [Summary.Details]) as data, e.g.
+// "The {{.resource}} was not found.". It is safe for concurrent use.
+type MessageCatalog struct {
+	m               *sync.RWMutex
+	defaultLanguage string
+	bundles         map[string]map[string]*template.Template
+}
+
+// NewMessageCatalog initializes a new, empty [*MessageCatalog]. The default language is used as the last fallback when
+// looking up messages, and can be empty for none.
+func NewMessageCatalog(defaultLanguage string) *MessageCatalog {
+	return &MessageCatalog{
+		m:               &sync.RWMutex{},
+		defaultLanguage: normalizeLanguage(defaultLanguage),
+		bundles:         make(map[string]map[string]*template.Template),
+	}
+}
+
+// AddMessages adds the given messages (keyed by error name) for the given language, replacing any existing ones with
+// the same name. Either all or none of the messages are added.
+func (c *MessageCatalog) AddMessages(language string, messages map[string]string) error {
+	language = normalizeLanguage(language)
+	tmpls := make(map[string]*template.Template, len(messages))
+
+	for name, message := range messages {
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(message)
+		if err != nil {
+			return Wrap(err)
+		}
+
+		tmpls[name] = tmpl
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	bundle, ok := c.bundles[language]
+	if !ok {
+		bundle = make(map[string]*template.Template, len(tmpls))
+		c.bundles[language] = bundle
+	}
+
+	for name, tmpl := range tmpls {
+		bundle[name] = tmpl
+	}
+
+	return nil
+}
+
+// LoadJSON adds messages for the given language from a JSON object mapping error names to messages.
+func (c *MessageCatalog) LoadJSON(language string, buf []byte) error {
+	messages := make(map[string]string)
+
+	if err := json.Unmarshal(buf, &messages); err != nil {
+		return Wrap(err)
+	}
+
+	return c.AddMessages(language, messages)
+}
+
+// LoadFS adds messages from all the files matching pattern (as in [fs.Glob]) in fsys, e.g. an [embed.FS]. Each file
+// must be a JSON object as in [MessageCatalog.LoadJSON], and its language is its base name without the extension, e.g.
+// "en-US" for "i18n/en-US.json".
+func (c *MessageCatalog) LoadFS(fsys fs.FS, pattern string) error {
+	filePaths, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return Wrap(err)
+	}
+
+	for _, filePath := range filePaths {
+		buf, err := fs.ReadFile(fsys, filePath)
+		if err != nil {
+			return Wrap(err)
+		}
+
+		base := path.Base(filePath)
+
+		if err := c.LoadJSON(strings.TrimSuffix(base, path.Ext(base)), buf); err != nil {
+			return Wrap(err, Errorf("cannot load messages from %v", filePath))
+		}
+	}
+
+	return nil
+}
+
+// GetMessage returns the message for the given language and error name, executed with details as data. It looks up
+// the language first, then its less specific versions (e.g. "pt" for "pt-BR"), then the default language (and its
+// less specific versions). Languages are case-insensitive, and "_" is equivalent to "-". Messages which fail to
+// execute (e.g. because of a missing detail) are skipped. Returns false if no message is found.
+func (c *MessageCatalog) GetMessage(language, name string, details map[string]any) (string, bool) {
+	c.m.RLock()
+	defer c.m.RUnlock()
+
+	for _, l := range c.getLanguageFallbacks(language) {
+		tmpl, ok := c.bundles[l][name]
+		if !ok {
+			continue
+		}
+
+		w := &strings.Builder{}
+
+		if err := tmpl.Execute(w, details); err == nil {
+			return w.String(), true
+		}
+	}
+
+	return "", false
+}
+
+func (c *MessageCatalog) getLanguageFallbacks(language string) []string {
+	languages := make([]string, 0, 4)
+
+	for _, l := range []string{normalizeLanguage(language), c.defaultLanguage} {
+		for l != "" {
+			languages = append(languages, l)
+
+			i := strings.LastIndex(l, "-")
+			if i < 0 {
+				break
+			}
+
+			l = l[:i]
+		}
+	}
+
+	return languages
+}
+
+func normalizeLanguage(language string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(language), "_", "-"))
+}
@@ -0,0 +1,166 @@
+package errorz_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+func TestMessageCatalog(t *testing.T) {
+	g := NewWithT(t)
+	c := errorz.NewMessageCatalog("en")
+
+	g.Expect(c.AddMessages("en", map[string]string{
+		"not-found":  "The {{.resource}} was not found.",
+		"internal":   "Something went wrong.",
+		"only-en":    "Only English.",
+		"bad-detail": "Value: {{.missing}}.",
+	})).To(Succeed())
+
+	g.Expect(c.AddMessages("IT", map[string]string{
+		"not-found":  "Risorsa non trovata: {{.resource}}.",
+		"bad-detail": "Valore: {{.missing}}.",
+	})).To(Succeed())
+
+	g.Expect(c.AddMessages("it_CH", map[string]string{
+		"internal": "Qualcosa è andato storto.",
+	})).To(Succeed())
+
+	g.Expect(c.AddMessages("it", map[string]string{
+		"bad-template": "{{.x",
+	})).To(MatchError(ContainSubstring("unclosed action")))
+
+	details := map[string]any{"resource": "user"}
+
+	type testCase struct {
+		language string
+		name     string
+		message  string
+		ok       bool
+	}
+
+	for _, tc := range []testCase{
+		{"en", "not-found", "The user was not found.", true},
+		{"en-US", "not-found", "The user was not found.", true},
+		{"", "not-found", "The user was not found.", true},
+		{"fr", "not-found", "The user was not found.", true},
+		{"it", "not-found", "Risorsa non trovata: user.", true},
+		{"it-CH", "not-found", "Risorsa non trovata: user.", true},
+		{"it-ch", "internal", "Qualcosa è andato storto.", true},
+		{"it", "internal", "Something went wrong.", true},
+		{"it", "only-en", "Only English.", true},
+		{"it", "bad-detail", "", false},
+		{"it", "other", "", false},
+	} {
+		message, ok := c.GetMessage(tc.language, tc.name, details)
+		g.Expect(message).To(Equal(tc.message), "%v/%v", tc.language, tc.name)
+		g.Expect(ok).To(Equal(tc.ok), "%v/%v", tc.language, tc.name)
+	}
+
+	message, ok := c.GetMessage("it", "bad-detail", map[string]any{"missing": 1})
+	g.Expect(ok).To(BeTrue())
+	g.Expect(message).To(Equal("Valore: 1."))
+
+	message, ok = errorz.NewMessageCatalog("").GetMessage("en", "not-found", details)
+	g.Expect(ok).To(BeFalse())
+	g.Expect(message).To(BeEmpty())
+}
+
+func TestMessageCatalog_LoadJSON(t *testing.T) {
+	g := NewWithT(t)
+	c := errorz.NewMessageCatalog("en")
+
+	g.Expect(c.LoadJSON("en", []byte(`{"not-found": "Not found."}`))).To(Succeed())
+	g.Expect(c.LoadJSON("en", []byte(`{`))).ToNot(Succeed())
+
+	message, ok := c.GetMessage("en", "not-found", nil)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(message).To(Equal("Not found."))
+}
+
+func TestMessageCatalog_LoadFS(t *testing.T) {
+	g := NewWithT(t)
+	c := errorz.NewMessageCatalog("en")
+
+	fsys := fstest.MapFS{
+		"i18n/en.json":    {Data: []byte(`{"not-found": "Not found."}`)},
+		"i18n/de-DE.json": {Data: []byte(`{"not-found": "Nicht gefunden."}`)},
+		"i18n/README.md":  {Data: []byte(`readme`)},
+	}
+
+	g.Expect(c.LoadFS(fsys, "i18n/*.json")).To(Succeed())
+
+	message, ok := c.GetMessage("de-DE", "not-found", nil)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(message).To(Equal("Nicht gefunden."))
+
+	message, ok = c.GetMessage("de", "not-found", nil)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(message).To(Equal("Not found."))
+
+	g.Expect(c.LoadFS(fsys, "[")).ToNot(Succeed())
+
+	fsys["i18n/it.json"] = &fstest.MapFile{Data: []byte(`{`)}
+	g.Expect(c.LoadFS(fsys, "i18n/*.json")).To(MatchError(ContainSubstring("cannot load messages from i18n/it.json")))
+}
+
+func TestGetSummaryWithOptions_UserMessage(t *testing.T) {
+	g := NewWithT(t)
+	c := errorz.NewMessageCatalog("en")
+
+	g.Expect(c.AddMessages("en", map[string]string{
+		"not-found":     "The {{.resource}} was not found.",
+		"invalid-email": "Invalid email: {{.email}}.",
+	})).To(Succeed())
+
+	g.Expect(c.AddMessages("it", map[string]string{
+		"not-found": "Risorsa non trovata: {{.resource}}.",
+	})).To(Succeed())
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "user 123 not found",
+		Name:         "not-found",
+		HTTPStatus:   404,
+		Details:      map[string]any{"resource": "user"},
+	})
+
+	s := errorz.GetSummaryWithOptions(err, false, &errorz.SummaryOptions{
+		MessageCatalog: c,
+		Language:       "it-IT",
+	})
+	g.Expect(s.Message).To(Equal("user 123 not found"))
+	g.Expect(s.UserMessage).To(Equal("Risorsa non trovata: user."))
+
+	s = errorz.GetSummaryWithOptions(err, false, &errorz.SummaryOptions{
+		MessageCatalog: c,
+		Language:       "fr",
+	})
+	g.Expect(s.UserMessage).To(Equal("The user was not found."))
+
+	g.Expect(errorz.GetSummary(err, false).UserMessage).To(BeEmpty())
+
+	err = errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "invalid email",
+		Name:         "invalid-email",
+		Details:      map[string]any{"email": "user@example.com"},
+	})
+
+	s = errorz.GetSummaryWithOptions(err, false, &errorz.SummaryOptions{
+		Audience:       errorz.SummaryAudiencePublic,
+		MessageCatalog: c,
+		Language:       "en",
+	})
+	g.Expect(s.Details).To(BeEmpty())
+	g.Expect(s.UserMessage).To(BeEmpty())
+
+	s = errorz.GetSummaryWithOptions(err, false, &errorz.SummaryOptions{
+		Audience:       errorz.SummaryAudienceInternal,
+		MessageCatalog: c,
+		Language:       "en",
+	})
+	g.Expect(s.UserMessage).To(Equal("Invalid email: [redacted]."))
+}
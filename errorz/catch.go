@@ -2,6 +2,7 @@ package errorz
 
 import (
 	"context"
+	"time"
 )
 
 // Catch0 catches panics in a "func() error" closure.
@@ -10,20 +11,26 @@ func Catch0(f func() error) (outErr error) {
 		if rErr := MaybeWrapRecover(recover()); rErr != nil {
 			outErr = rErr
 		}
+
+		maybeRunHooks(HookTypeCatch, outErr)
 	}()
 
 	return MaybeWrap(f())
 }
 
-// Catch0Ctx catches panics in a "func(context.Context) error" closure.
+// Catch0Ctx catches panics in a "func(context.Context) error" closure. Context errors are converted using [WrapCtx].
 func Catch0Ctx(ctx context.Context, f func(ctx context.Context) error) (outErr error) {
+	start := time.Now()
+
 	defer func() {
 		if rErr := MaybeWrapRecover(recover()); rErr != nil {
 			outErr = rErr
 		}
+
+		maybeRunHooks(HookTypeCatch, outErr)
 	}()
 
-	return MaybeWrap(f(ctx))
+	return maybeWrapCtx(ctx, f(ctx), start)
 }
 
 // Catch1 catches panics in a "func() (T, error)" closure.
@@ -34,24 +41,31 @@ func Catch1[T any](f func() (T, error)) (outV T, outErr error) {
 			outV = t
 			outErr = rErr
 		}
+
+		maybeRunHooks(HookTypeCatch, outErr)
 	}()
 
 	out, err := f()
 	return out, MaybeWrap(err)
 }
 
-// Catch1Ctx catches panics in a "func(context.Context) (T, error)" closure.
+// Catch1Ctx catches panics in a "func(context.Context) (T, error)" closure. Context errors are converted using
+// [WrapCtx].
 func Catch1Ctx[T any](ctx context.Context, f func(ctx context.Context) (T, error)) (outV T, outErr error) {
+	start := time.Now()
+
 	defer func() {
 		if rErr := MaybeWrapRecover(recover()); rErr != nil {
 			var t T
 			outV = t
 			outErr = rErr
 		}
+
+		maybeRunHooks(HookTypeCatch, outErr)
 	}()
 
 	out, err := f(ctx)
-	return out, MaybeWrap(err)
+	return out, maybeWrapCtx(ctx, err, start)
 }
 
 // Catch2 catches panics in a "func() (T1, T2, error)" closure.
@@ -65,18 +79,23 @@ func Catch2[T1 any, T2 any](f func() (T1, T2, error)) (outV1 T1, outV2 T2, outEr
 			outV2 = t2
 			outErr = rErr
 		}
+
+		maybeRunHooks(HookTypeCatch, outErr)
 	}()
 
 	out1, out2, err := f()
 	return out1, out2, MaybeWrap(err)
 }
 
-// Catch2Ctx catches panics in a "func(context.Context) (T1, T2, error)" closure.
+// Catch2Ctx catches panics in a "func(context.Context) (T1, T2, error)" closure. Context errors are converted using
+// [WrapCtx].
 func Catch2Ctx[T1 any, T2 any](
 	ctx context.Context,
 	f func(ctx context.Context) (T1, T2, error),
 ) (outV1 T1, outV2 T2, outErr error) {
 
+	start := time.Now()
+
 	defer func() {
 		if rErr := MaybeWrapRecover(recover()); rErr != nil {
 			var t1 T1
@@ -86,10 +105,12 @@ func Catch2Ctx[T1 any, T2 any](
 			outV2 = t2
 			outErr = rErr
 		}
+
+		maybeRunHooks(HookTypeCatch, outErr)
 	}()
 
 	out1, out2, err := f(ctx)
-	return out1, out2, MaybeWrap(err)
+	return out1, out2, maybeWrapCtx(ctx, err, start)
 }
 
 // Catch3 catches panics in a "func() (T1, T2, T3, error)" closure.
@@ -105,18 +126,23 @@ func Catch3[T1 any, T2 any, T3 any](f func() (T1, T2, T3, error)) (outV1 T1, out
 			outV3 = t3
 			outErr = rErr
 		}
+
+		maybeRunHooks(HookTypeCatch, outErr)
 	}()
 
 	out1, out2, out3, err := f()
 	return out1, out2, out3, MaybeWrap(err)
 }
 
-// Catch3Ctx catches panics in a "func(context.Context) (T1, T2, T3, error)" closure.
+// Catch3Ctx catches panics in a "func(context.Context) (T1, T2, T3, error)" closure. Context errors are converted using
+// [WrapCtx].
 func Catch3Ctx[T1 any, T2 any, T3 any](
 	ctx context.Context,
 	f func(ctx context.Context) (T1, T2, T3, error),
 ) (outV1 T1, outV2 T2, outV3 T3, outErr error) {
 
+	start := time.Now()
+
 	defer func() {
 		if rErr := MaybeWrapRecover(recover()); rErr != nil {
 			var t1 T1
@@ -128,8 +154,10 @@ func Catch3Ctx[T1 any, T2 any, T3 any](
 			outV3 = t3
 			outErr = rErr
 		}
+
+		maybeRunHooks(HookTypeCatch, outErr)
 	}()
 
 	out1, out2, out3, err := f(ctx)
-	return out1, out2, out3, MaybeWrap(err)
+	return out1, out2, out3, maybeWrapCtx(ctx, err, start)
 }
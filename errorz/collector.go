@@ -0,0 +1,205 @@
+package errorz
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Collector accumulates errors tagged with keys (e.g. item indexes, IDs, or field paths), for example in batch jobs or
+// validation loops. It retains at most a maximum number of errors, and counts the rest as overflow. It is safe for
+// concurrent use.
+type Collector struct {
+	m         *sync.Mutex
+	maxErrors int
+	keys      []string
+	errs      []error
+	overflow  int
+}
+
+// NewCollector initializes a new [*Collector] which retains at most maxErrors errors, or all of them if
+// maxErrors <= 0.
+func NewCollector(maxErrors int) *Collector {
+	return &Collector{
+		m:         &sync.Mutex{},
+		maxErrors: maxErrors,
+		keys:      nil,
+		errs:      nil,
+		overflow:  0,
+	}
+}
+
+// Add adds an error with the given key, or does nothing if err is nil. It returns true if err is not nil.
+func (c *Collector) Add(key string, err error) bool {
+	if err == nil {
+		return false
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if c.maxErrors > 0 && len(c.errs) >= c.maxErrors {
+		c.overflow++
+		return true
+	}
+
+	c.keys = append(c.keys, key)
+	c.errs = append(c.errs, err)
+	return true
+}
+
+// AddIndex is like [Collector.Add], but uses an index as key.
+func (c *Collector) AddIndex(i int, err error) bool {
+	return c.Add(strconv.Itoa(i), err)
+}
+
+// Len returns the number of errors added so far, including overflow.
+func (c *Collector) Len() int {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	return len(c.errs) + c.overflow
+}
+
+// Err returns a wrapped [*MultiError] containing the errors added so far, or nil if none was added.
+func (c *Collector) Err() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+
+	if len(c.errs) == 0 && c.overflow == 0 {
+		return nil
+	}
+
+	return Wrap(&MultiError{
+		keys:     slices.Clone(c.keys),
+		errs:     slices.Clone(c.errs),
+		overflow: c.overflow,
+	})
+}
+
+var (
+	_ error           = (*MultiError)(nil)
+	_ ErrorName       = (*MultiError)(nil)
+	_ ErrorDetails    = (*MultiError)(nil)
+	_ ErrorHTTPStatus = (*MultiError)(nil)
+	_ ErrorKind       = (*MultiError)(nil)
+	_ UnwrapMulti     = (*MultiError)(nil)
+)
+
+// MultiError describes multiple errors tagged with keys, as accumulated by a [*Collector].
+type MultiError struct {
+	keys     []string
+	errs     []error
+	overflow int
+}
+
+// Error implements the error interface.
+func (e *MultiError) Error() string {
+	w := &strings.Builder{}
+	_, _ = fmt.Fprintf(w, "%v error(s)", e.GetCount())
+
+	for i, err := range e.errs {
+		if i == 0 {
+			_, _ = w.WriteString(": ")
+		} else {
+			_, _ = w.WriteString("; ")
+		}
+
+		_, _ = fmt.Fprintf(w, "%v: %v", e.keys[i], err.Error())
+	}
+
+	if e.overflow > 0 {
+		_, _ = fmt.Fprintf(w, " (and %v more)", e.overflow)
+	}
+
+	return w.String()
+}
+
+// GetErrorName implements the [ErrorName] interface.
+func (*MultiError) GetErrorName() string {
+	return "multi-error"
+}
+
+// GetErrorDetails implements the [ErrorDetails] interface. The "errors" detail maps each key to the message of the
+// corresponding error (joined with "; " if multiple errors have the same key).
+func (e *MultiError) GetErrorDetails() map[string]any {
+	errs := make(map[string]string, len(e.errs))
+
+	for i, err := range e.errs {
+		if msg, ok := errs[e.keys[i]]; ok {
+			errs[e.keys[i]] = msg + "; " + err.Error()
+			continue
+		}
+
+		errs[e.keys[i]] = err.Error()
+	}
+
+	details := map[string]any{
+		"count":  e.GetCount(),
+		"errors": errs,
+	}
+
+	if e.overflow > 0 {
+		details["overflow"] = e.overflow
+	}
+
+	return details
+}
+
+// GetErrorHTTPStatus implements the [ErrorHTTPStatus] interface. It returns the HTTP status of the kind returned by
+// [MultiError.GetErrorKind], or zero if there is no such kind.
+func (e *MultiError) GetErrorHTTPStatus() int {
+	if k := e.GetErrorKind(); k != nil {
+		return k.GetHTTPStatus()
+	}
+
+	return 0
+}
+
+// GetErrorKind implements the [ErrorKind] interface. If all the retained errors have the same kind (see [KindOf]), it
+// returns that kind. If they have different kinds, it returns [KindUnknown]. If none of them has a kind, it returns
+// nil.
+func (e *MultiError) GetErrorKind() *Kind {
+	var k *Kind
+
+	for i, err := range e.errs {
+		if ek := KindOf(err); i == 0 {
+			k = ek
+		} else if ek != k {
+			return KindUnknown
+		}
+	}
+
+	return k
+}
+
+// Unwrap implements the [UnwrapMulti] interface.
+func (e *MultiError) Unwrap() []error {
+	if e == nil || len(e.errs) == 0 {
+		return nil
+	}
+
+	return e.errs
+}
+
+// GetKeys returns a copy of the keys of the retained errors, in the order they were added.
+func (e *MultiError) GetKeys() []string {
+	return slices.Clone(e.keys)
+}
+
+// GetErrors returns a copy of the retained errors, in the order they were added.
+func (e *MultiError) GetErrors() []error {
+	return slices.Clone(e.errs)
+}
+
+// GetOverflow returns the number of errors which were not retained.
+func (e *MultiError) GetOverflow() int {
+	return e.overflow
+}
+
+// GetCount returns the total number of errors, including overflow.
+func (e *MultiError) GetCount() int {
+	return len(e.errs) + e.overflow
+}
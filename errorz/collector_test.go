@@ -0,0 +1,141 @@
+package errorz_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+func TestCollector(t *testing.T) {
+	g := NewWithT(t)
+	c := errorz.NewCollector(0)
+
+	g.Expect(c.Err()).To(Succeed())
+	g.Expect(c.Len()).To(Equal(0))
+
+	g.Expect(c.Add("a", nil)).To(BeFalse())
+	g.Expect(c.Add("a", fmt.Errorf("e1"))).To(BeTrue())
+	g.Expect(c.AddIndex(2, errorz.NotFoundf("e2"))).To(BeTrue())
+	g.Expect(c.Add("a", fmt.Errorf("e3"))).To(BeTrue())
+	g.Expect(c.Len()).To(Equal(3))
+
+	err := c.Err()
+	g.Expect(err).To(MatchError("3 error(s): a: e1; 2: e2; a: e3"))
+	g.Expect(errorz.GetFrames(err)[0].ShortLocation).To(Equal("errorz_test.TestCollector"))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindUnknown))
+
+	s := errorz.GetSummary(err, true)
+	g.Expect(s.Components[0].Components[0]).To(Equal(&errorz.Summary{
+		Name:       "multi-error",
+		Message:    "3 error(s): a: e1; 2: e2; a: e3",
+		HTTPStatus: 500,
+		Details: map[string]any{
+			"count":  3,
+			"errors": map[string]string{"a": "e1; e3", "2": "e2"},
+		},
+		Components: []*errorz.Summary{
+			{Message: "e1"},
+			s.Components[0].Components[0].Components[1],
+			{Message: "e3"},
+		},
+	}))
+	g.Expect(s.Components[0].Components[0].Components[1].Name).To(Equal("[wrap]"))
+	g.Expect(s.HTTPStatus).To(Equal(500))
+
+	mErr, ok := errorz.As[*errorz.MultiError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(mErr.GetKeys()).To(Equal([]string{"a", "2", "a"}))
+	g.Expect(mErr.GetErrors()).To(HaveLen(3))
+	g.Expect(mErr.GetOverflow()).To(Equal(0))
+	g.Expect(mErr.GetCount()).To(Equal(3))
+	g.Expect(errorz.Flatten(err)).To(HaveLen(3))
+
+	mErr.GetKeys()[0] = "x"
+	mErr.GetErrors()[0] = nil
+	g.Expect(mErr.GetKeys()[0]).To(Equal("a"))
+	g.Expect(mErr.GetErrors()[0]).To(MatchError("e1"))
+
+	c.Add("b", fmt.Errorf("e4"))
+	g.Expect(mErr.GetErrors()).To(HaveLen(3))
+}
+
+func TestCollector_Kind(t *testing.T) {
+	g := NewWithT(t)
+
+	c := errorz.NewCollector(0)
+	c.Add("a", errorz.InvalidArgumentf("e1"))
+	c.Add("b", errorz.InvalidArgumentf("e2"))
+
+	err := c.Err()
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindInvalidArgument))
+
+	s := errorz.GetSummary(err, false)
+	g.Expect(s.Name).To(Equal("invalid-argument"))
+	g.Expect(s.HTTPStatus).To(Equal(400))
+
+	c = errorz.NewCollector(0)
+	c.Add("a", fmt.Errorf("e1"))
+	c.Add("b", fmt.Errorf("e2"))
+
+	err = c.Err()
+	g.Expect(errorz.KindOf(err)).To(BeNil())
+	g.Expect(errorz.GetSummary(err, false).HTTPStatus).To(BeZero())
+}
+
+func TestCollector_Overflow(t *testing.T) {
+	g := NewWithT(t)
+	c := errorz.NewCollector(2)
+
+	for i := range 5 {
+		c.AddIndex(i, fmt.Errorf("e%v", i))
+	}
+
+	g.Expect(c.Len()).To(Equal(5))
+
+	err := c.Err()
+	g.Expect(err).To(MatchError("5 error(s): 0: e0; 1: e1 (and 3 more)"))
+	g.Expect(errorz.GetSummary(err, false).Details).To(Equal(map[string]any{
+		"count":    5,
+		"errors":   map[string]string{"0": "e0", "1": "e1"},
+		"overflow": 3,
+	}))
+
+	mErr, ok := errorz.As[*errorz.MultiError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(mErr.GetOverflow()).To(Equal(3))
+	g.Expect(mErr.GetCount()).To(Equal(5))
+
+	c = errorz.NewCollector(-1)
+	c.Add("a", fmt.Errorf("e"))
+	g.Expect(c.Err()).To(MatchError("1 error(s): a: e"))
+
+	g.Expect((&errorz.MultiError{}).Error()).To(Equal("0 error(s)"))
+	g.Expect((&errorz.MultiError{}).Unwrap()).To(BeNil())
+}
+
+func TestCollector_Concurrent(t *testing.T) {
+	g := NewWithT(t)
+	c := errorz.NewCollector(10)
+	wg := &sync.WaitGroup{}
+
+	for i := range 100 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			c.AddIndex(i, fmt.Errorf("e%v", i))
+		}()
+	}
+
+	wg.Wait()
+	g.Expect(c.Len()).To(Equal(100))
+
+	mErr, ok := errorz.As[*errorz.MultiError](c.Err())
+	g.Expect(ok).To(BeTrue())
+	g.Expect(mErr.GetErrors()).To(HaveLen(10))
+	g.Expect(mErr.GetOverflow()).To(Equal(90))
+}
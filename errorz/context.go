@@ -0,0 +1,212 @@
+package errorz
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+// WrapCtx is like [Wrap], but if err is (or wraps) [context.Canceled] or [context.DeadlineExceeded], it first converts
+// it to a [*ContextError] which describes why and when the context was done. If err is a wrapped or joined error, only
+// the errors it contains which are (or wrap) a context error are converted, so that metadata, frames, and sibling
+// errors are preserved.
+func WrapCtx(ctx context.Context, err error, outerErrs ...error) error {
+	return wrapCtx(ctx, err, time.Time{}, outerErrs...)
+}
+
+// MaybeWrapCtx is like [WrapCtx], but returns nil if called with a nil error.
+func MaybeWrapCtx(ctx context.Context, err error, outerErrs ...error) error {
+	if err != nil {
+		return WrapCtx(ctx, err, outerErrs...)
+	}
+
+	return nil
+}
+
+func maybeWrapCtx(ctx context.Context, err error, start time.Time) error {
+	if err != nil {
+		return wrapCtx(ctx, err, start)
+	}
+
+	return nil
+}
+
+func wrapCtx(ctx context.Context, err error, start time.Time, outerErrs ...error) error {
+	if err == nil {
+		MustErrorf("err is nil")
+	}
+
+	if _, ok := As[*ContextError](err); ok {
+		return Wrap(err, outerErrs...)
+	}
+
+	if !isCtxError(err) {
+		return Wrap(err, outerErrs...)
+	}
+
+	newContextError := func(err error) *ContextError {
+		cErr := &ContextError{
+			err:         err,
+			cause:       nil,
+			deadline:    time.Time{},
+			hasDeadline: false,
+			elapsed:     0,
+		}
+
+		if ctx != nil {
+			if ctx.Err() != nil {
+				if cause := context.Cause(ctx); cause != nil && cause != ctx.Err() { //nolint:errorlint
+					cErr.cause = cause
+				}
+			}
+
+			cErr.deadline, cErr.hasDeadline = ctx.Deadline()
+		}
+
+		if !start.IsZero() {
+			cErr.elapsed = time.Since(start)
+		}
+
+		return cErr
+	}
+
+	return Wrap(replaceCtxError(err, newContextError), outerErrs...)
+}
+
+// replaceCtxError replaces the parts of err which are (or wrap) a context error with a [*ContextError], descending
+// into wrapped and joined errors, so that any metadata, frames, and sibling errors are preserved. Other errors wrapping
+// a context error are replaced as a whole.
+func replaceCtxError(err error, newContextError func(err error) *ContextError) error {
+	if wErr, ok := err.(*wrappedError); ok { //nolint:errorlint
+		wErr.m.Lock()
+		defer wErr.m.Unlock()
+
+		for i, e := range wErr.errs {
+			if isCtxError(e) {
+				wErr.errs[i] = replaceCtxError(e, newContextError)
+			}
+		}
+
+		return wErr
+	}
+
+	if isJoinError(err) {
+		errs := slices.Clone(err.(UnwrapMulti).Unwrap()) //nolint:errorlint,forcetypeassert
+
+		for i, e := range errs {
+			if isCtxError(e) {
+				errs[i] = replaceCtxError(e, newContextError)
+			}
+		}
+
+		return errors.Join(errs...)
+	}
+
+	return newContextError(err)
+}
+
+func isCtxError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+var (
+	_ error           = (*ContextError)(nil)
+	_ ErrorName       = (*ContextError)(nil)
+	_ ErrorDetails    = (*ContextError)(nil)
+	_ ErrorHTTPStatus = (*ContextError)(nil)
+	_ ErrorKind       = (*ContextError)(nil)
+)
+
+// ContextError describes a [context.Canceled] or [context.DeadlineExceeded] error, along with the cause, deadline, and
+// elapsed time (if known). It is created by [WrapCtx] and by the "Catch*Ctx" functions.
+type ContextError struct {
+	err         error
+	cause       error
+	deadline    time.Time
+	hasDeadline bool
+	elapsed     time.Duration
+}
+
+// Error implements the error interface.
+func (e *ContextError) Error() string {
+	return e.err.Error()
+}
+
+// GetErrorName implements the [ErrorName] interface.
+func (e *ContextError) GetErrorName() string {
+	if e.isDeadlineExceeded() {
+		return "context-deadline-exceeded"
+	}
+
+	return "context-canceled"
+}
+
+// GetErrorDetails implements the [ErrorDetails] interface.
+func (e *ContextError) GetErrorDetails() map[string]any {
+	details := make(map[string]any)
+
+	if e.cause != nil {
+		details["cause"] = e.cause.Error()
+	}
+
+	if e.hasDeadline {
+		details["deadline"] = e.deadline.Format(time.RFC3339Nano)
+	}
+
+	if e.elapsed > 0 {
+		details["elapsed"] = e.elapsed.String()
+	}
+
+	return details
+}
+
+// GetErrorHTTPStatus implements the [ErrorHTTPStatus] interface.
+func (e *ContextError) GetErrorHTTPStatus() int {
+	return e.GetErrorKind().GetHTTPStatus()
+}
+
+// GetErrorKind implements the [ErrorKind] interface.
+func (e *ContextError) GetErrorKind() *Kind {
+	if e.isDeadlineExceeded() {
+		return KindDeadlineExceeded
+	}
+
+	return KindCanceled
+}
+
+// Is allows [errors.Is] to match the original error and the cause (if any). The original error is intentionally not
+// exposed via Unwrap, so that it doesn't appear as a separate component in summaries.
+func (e *ContextError) Is(target error) bool {
+	return errors.Is(e.err, target) || (e.cause != nil && errors.Is(e.cause, target))
+}
+
+// As allows [errors.As] to match the original error and the cause (if any).
+func (e *ContextError) As(target any) bool {
+	return errors.As(e.err, target) || (e.cause != nil && errors.As(e.cause, target))
+}
+
+// GetError returns the original error.
+func (e *ContextError) GetError() error {
+	return e.err
+}
+
+// GetCause returns the cause of the context cancellation as returned by [context.Cause], if different from the
+// context error itself, nil otherwise.
+func (e *ContextError) GetCause() error {
+	return e.cause
+}
+
+// GetDeadline returns the context deadline, if any.
+func (e *ContextError) GetDeadline() (time.Time, bool) {
+	return e.deadline, e.hasDeadline
+}
+
+// GetElapsed returns the time elapsed between the start of the operation and the error, or zero if unknown.
+func (e *ContextError) GetElapsed() time.Duration {
+	return e.elapsed
+}
+
+func (e *ContextError) isDeadlineExceeded() bool {
+	return errors.Is(e.err, context.DeadlineExceeded)
+}
@@ -0,0 +1,179 @@
+package errorz_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+func TestWrapCtx_Canceled(t *testing.T) {
+	g := NewWithT(t)
+
+	cause := errorz.Unavailablef("shutting down")
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(cause)
+
+	err := errorz.WrapCtx(ctx, ctx.Err())
+	g.Expect(err).To(MatchError("context canceled"))
+	g.Expect(err).To(MatchError(context.Canceled))
+	g.Expect(err).To(MatchError(cause))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindCanceled))
+
+	cErr, ok := errorz.As[*errorz.ContextError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(cErr.GetCause()).To(BeIdenticalTo(cause))
+	_, ok = cErr.GetDeadline()
+	g.Expect(ok).To(BeFalse())
+	g.Expect(cErr.GetElapsed()).To(BeZero())
+
+	g.Expect(errorz.GetSummary(err, false)).To(Equal(&errorz.Summary{
+		Name:       "context-canceled",
+		Message:    "context canceled",
+		HTTPStatus: 499,
+		Details:    map[string]any{"cause": "shutting down"},
+	}))
+
+	g.Expect(errorz.WrapCtx(ctx, err)).To(BeIdenticalTo(err))
+}
+
+func TestWrapCtx_DeadlineExceeded(t *testing.T) {
+	g := NewWithT(t)
+
+	deadline := time.Now().Add(-time.Second)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	err := errorz.WrapCtx(ctx, fmt.Errorf("call failed: %w", ctx.Err()), fmt.Errorf("outer"))
+	g.Expect(err).To(MatchError("outer: call failed: context deadline exceeded"))
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindDeadlineExceeded))
+
+	cErr, ok := errorz.As[*errorz.ContextError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(cErr.GetCause()).To(BeNil())
+	g.Expect(cErr.GetError()).To(MatchError("call failed: context deadline exceeded"))
+	d, ok := cErr.GetDeadline()
+	g.Expect(ok).To(BeTrue())
+	g.Expect(d).To(BeTemporally("==", deadline))
+
+	s := errorz.GetSummary(err, false)
+	g.Expect(s.Name).To(Equal("context-deadline-exceeded"))
+	g.Expect(s.HTTPStatus).To(Equal(504))
+	g.Expect(s.Details).To(Equal(map[string]any{"deadline": deadline.Format(time.RFC3339Nano)}))
+}
+
+func TestWrapCtx_Metadata(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	wErr := errorz.Wrap(fmt.Errorf("call failed: %w", ctx.Err()))
+	errorz.MaybeSetMetadata(wErr, "k", "v")
+	frames := errorz.GetFrames(wErr)
+
+	err := errorz.WrapCtx(ctx, wErr)
+	g.Expect(err).To(BeIdenticalTo(wErr))
+	g.Expect(err).To(MatchError("call failed: context canceled"))
+	g.Expect(err).To(MatchError(context.Canceled))
+	g.Expect(errorz.MustGetMetadata[string](err, "k")).To(Equal("v"))
+	g.Expect(errorz.GetFrames(err)).To(Equal(frames))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindCanceled))
+
+	_, ok := errorz.As[*errorz.ContextError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(errorz.GetSummary(err, false).Name).To(Equal("context-canceled"))
+}
+
+func TestWrapCtx_Join(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	jErr := errors.Join(&json.UnsupportedValueError{Str: "bad value"}, ctx.Err())
+	err := errorz.WrapCtx(ctx, jErr)
+	g.Expect(err).To(MatchError(context.Canceled))
+	g.Expect(errorz.Unwrap(err)).To(HaveLen(1))
+
+	_, ok := errorz.As[*json.UnsupportedValueError](err)
+	g.Expect(ok).To(BeTrue())
+	_, ok = errorz.As[*errorz.ContextError](err)
+	g.Expect(ok).To(BeTrue())
+
+	s := errorz.GetSummary(err, true)
+	g.Expect(s.Message).To(Equal(jErr.Error()))
+	g.Expect(s.Components[0].Components[0].Name).To(Equal("[join]"))
+	g.Expect(s.Components[0].Components[0].Components).To(HaveLen(2))
+	g.Expect(s.Components[0].Components[0].Components[1].Name).To(Equal("context-canceled"))
+	g.Expect(s.HTTPStatus).To(Equal(499))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindCanceled))
+}
+
+func TestWrapCtx_Other(t *testing.T) {
+	g := NewWithT(t)
+
+	e := fmt.Errorf("e")
+	err := errorz.WrapCtx(context.Background(), e)
+	g.Expect(errorz.Unwrap(err)).To(HaveExactElements(e))
+
+	err = errorz.WrapCtx(nil, context.Canceled) //nolint:staticcheck
+	g.Expect(errorz.GetSummary(err, false).Details).To(BeEmpty())
+
+	g.Expect(errorz.MaybeWrapCtx(context.Background(), nil)).To(BeNil())
+	g.Expect(errorz.MaybeWrapCtx(context.Background(), context.Canceled)).To(MatchError(context.Canceled))
+	g.Expect(func() { _ = errorz.WrapCtx(context.Background(), nil) }).To(PanicWith(MatchError("err is nil")))
+
+	_, ok := errorz.As[*errorz.RetryError](errorz.WrapCtx(context.Background(), context.Canceled))
+	g.Expect(ok).To(BeFalse())
+	g.Expect(errors.Is(errorz.WrapCtx(context.Background(), context.Canceled), e)).To(BeFalse())
+}
+
+func TestCatchCtx_ContextError(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := errorz.Catch0Ctx(ctx, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	cErr, ok := errorz.As[*errorz.ContextError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(cErr.GetElapsed()).To(BeNumerically(">=", 10*time.Millisecond))
+	g.Expect(errorz.GetSummary(err, false).Details).To(HaveKey("elapsed"))
+
+	_, err = errorz.Catch1Ctx(ctx, func(ctx context.Context) (int, error) { return 0, ctx.Err() })
+	_, ok = errorz.As[*errorz.ContextError](err)
+	g.Expect(ok).To(BeTrue())
+}
+
+func TestCatchCtx_WrappedContextError(t *testing.T) {
+	g := NewWithT(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := errorz.Catch0Ctx(ctx, func(ctx context.Context) error {
+		<-ctx.Done()
+		return errorz.Wrap(ctx.Err())
+	})
+	g.Expect(err).To(MatchError("context deadline exceeded"))
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	g.Expect(errorz.GetFrames(err)[0].ShortLocation).To(Equal("errorz_test.TestCatchCtx_WrappedContextError.func1"))
+
+	s := errorz.GetSummary(err, false)
+	g.Expect(s.Name).To(Equal("context-deadline-exceeded"))
+	g.Expect(s.Message).To(Equal("context deadline exceeded"))
+	g.Expect(s.HTTPStatus).To(Equal(504))
+	g.Expect(s.Details).To(HaveKey("elapsed"))
+}
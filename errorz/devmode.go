@@ -0,0 +1,129 @@
+package errorz
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// DevModeOptions describes options for development mode. In development mode, summaries of wrapped errors include
+// snippets of source code around user code frames (see [Frame.UserCode]).
+type DevModeOptions struct {
+	// Enabled enables development mode.
+	Enabled bool
+	// SnippetLines is the number of lines to include before and after the line of each frame.
+	SnippetLines int
+	// MaxSnippets is the maximum number of snippets to include for each wrapped error.
+	MaxSnippets int
+}
+
+var (
+	defaultDevModeOptions = &DevModeOptions{
+		Enabled:      false,
+		SnippetLines: 2,
+		MaxSnippets:  3,
+	}
+)
+
+// DefaultDevModeOptions is a default, shared instance of [*DevModeOptions], used by [GetSummary] and related
+// functions (e.g. [SDump]). Development mode is disabled by default.
+var (
+	DefaultDevModeOptions = defaultDevModeOptions
+)
+
+// RestoreDefaultDevModeOptions restores the default value of [DefaultDevModeOptions].
+func RestoreDefaultDevModeOptions() {
+	DefaultDevModeOptions = defaultDevModeOptions
+}
+
+// Snippet describes a snippet of source code around a frame.
+type Snippet struct {
+	Frame string   `json:"frame,omitempty"`
+	Lines []string `json:"lines,omitempty"`
+}
+
+var (
+	sourceFilesM = &sync.Mutex{}
+	sourceFiles  = make(map[string][]string)
+)
+
+// GetSnippet returns a snippet of source code around the given frame, including the given number of lines before and
+// after the line of the frame (a negative number is treated as zero). Source files are read lazily and cached. It
+// returns false if the source file cannot be read (e.g. in binaries built with "-trimpath"), or if the line is out of
+// range.
+func GetSnippet(frame *Frame, lines int) (*Snippet, bool) {
+	if frame == nil || frame.File == "" || frame.Line <= 0 {
+		return nil, false
+	}
+
+	fileLines := getSourceFileLines(frame.File)
+	if frame.Line > len(fileLines) {
+		return nil, false
+	}
+
+	lines = max(lines, 0)
+	start := max(frame.Line-lines, 1)
+	end := min(frame.Line+lines, len(fileLines))
+	width := len(fmt.Sprintf("%v", end))
+
+	s := &Snippet{
+		Frame: frame.Summary,
+		Lines: make([]string, 0, end-start+1),
+	}
+
+	for i := start; i <= end; i++ {
+		marker := " "
+		if i == frame.Line {
+			marker = ">"
+		}
+
+		s.Lines = append(s.Lines, fmt.Sprintf("%v %*d | %v", marker, width, i, fileLines[i-1]))
+	}
+
+	return s, true
+}
+
+func getSourceFileLines(file string) []string {
+	sourceFilesM.Lock()
+	defer sourceFilesM.Unlock()
+
+	if fileLines, ok := sourceFiles[file]; ok {
+		return fileLines
+	}
+
+	var fileLines []string
+
+	if buf, err := os.ReadFile(file); err == nil {
+		buf = bytes.ReplaceAll(buf, []byte("\r\n"), []byte("\n"))
+		fileLines = strings.Split(strings.TrimSuffix(string(buf), "\n"), "\n")
+	}
+
+	sourceFiles[file] = fileLines
+	return fileLines
+}
+
+func getSnippets(err error) []*Snippet {
+	opts := DefaultDevModeOptions
+
+	if opts == nil || !opts.Enabled {
+		return nil
+	}
+
+	var snippets []*Snippet
+
+	for _, frame := range GetFrames(err) {
+		if len(snippets) >= opts.MaxSnippets {
+			break
+		}
+
+		if frame.UserCode {
+			if snippet, ok := GetSnippet(frame, opts.SnippetLines); ok {
+				snippets = append(snippets, snippet)
+			}
+		}
+	}
+
+	return snippets
+}
@@ -0,0 +1,93 @@
+package errorz_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+func TestGetSnippet(t *testing.T) {
+	g := NewWithT(t)
+
+	filePath := filepath.Join(t.TempDir(), "file.go")
+	g.Expect(os.WriteFile(filePath, []byte("l1\r\nl2\nl3\nl4\nl5\nl6\nl7\nl8\nl9\nl10\n"), 0600)).To(Succeed())
+
+	s, ok := errorz.GetSnippet(errorz.NewFrame("pkg.f", filePath, 9), 2)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(s).To(Equal(&errorz.Snippet{
+		Frame: "pkg.f (" + filePath + ":9)",
+		Lines: []string{
+			"   7 | l7",
+			"   8 | l8",
+			">  9 | l9",
+			"  10 | l10",
+		},
+	}))
+
+	s, ok = errorz.GetSnippet(errorz.NewFrame("pkg.f", filePath, 1), 1)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(s.Lines).To(HaveExactElements("> 1 | l1", "  2 | l2"))
+
+	s, ok = errorz.GetSnippet(errorz.NewFrame("pkg.f", filePath, 2), -2)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(s.Lines).To(HaveExactElements("> 2 | l2"))
+
+	g.Expect(os.Remove(filePath)).To(Succeed())
+	_, ok = errorz.GetSnippet(errorz.NewFrame("pkg.f", filePath, 1), 1)
+	g.Expect(ok).To(BeTrue())
+
+	_, ok = errorz.GetSnippet(errorz.NewFrame("pkg.f", filePath, 11), 1)
+	g.Expect(ok).To(BeFalse())
+
+	_, ok = errorz.GetSnippet(errorz.NewFrame("pkg.f", filepath.Join(t.TempDir(), "missing.go"), 1), 1)
+	g.Expect(ok).To(BeFalse())
+
+	_, ok = errorz.GetSnippet(errorz.NewFrame("pkg.f", "", 0), 1)
+	g.Expect(ok).To(BeFalse())
+
+	_, ok = errorz.GetSnippet(nil, 1)
+	g.Expect(ok).To(BeFalse())
+}
+
+// isSourceAvailable returns false if the source files of the running test cannot be read, e.g. with "-trimpath".
+func isSourceAvailable(err error) bool {
+	_, ok := errorz.GetSnippet(errorz.GetFrames(err)[0], 0)
+	return ok
+}
+
+func TestDevMode(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Errorf("e")
+	g.Expect(errorz.GetSummary(err, false).Snippets).To(BeNil())
+
+	errorz.DefaultDevModeOptions = &errorz.DevModeOptions{
+		Enabled:      true,
+		SnippetLines: 1,
+		MaxSnippets:  1,
+	}
+	defer errorz.RestoreDefaultDevModeOptions()
+
+	s := errorz.GetSummary(err, true)
+
+	if !isSourceAvailable(err) {
+		g.Expect(s.Snippets).To(BeNil())
+		g.Expect(errorz.SDump(err)).ToNot(BeEmpty())
+		return
+	}
+
+	g.Expect(s.Snippets).To(HaveLen(1))
+	g.Expect(s.Snippets[0].Frame).To(HavePrefix("errorz_test.TestDevMode ("))
+	g.Expect(s.Snippets[0].Lines).To(HaveLen(3))
+	g.Expect(s.Snippets[0].Lines[1]).To(MatchRegexp(`^> \d+ \| 	err := errorz\.Errorf\("e"\)$`))
+	g.Expect(s.Components[0].Snippets).To(Equal(s.Snippets))
+	g.Expect(errorz.SDump(err)).To(ContainSubstring(`err := errorz.Errorf(\"e\")`))
+
+	errorz.DefaultUserCodePackagePrefixes = []string{"other"}
+	defer errorz.RestoreDefaultUserCodePackagePrefixes()
+	g.Expect(errorz.GetSummary(errorz.Errorf("e"), false).Snippets).To(BeNil())
+}
@@ -1,6 +1,7 @@
 package errorz
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/davecgh/go-spew/spew"
@@ -20,21 +21,54 @@ var (
 	}
 )
 
-// SDump converts the error to a string representation for debug purposes.
+// SDump converts the error to a string representation for debug purposes. Frames and wrap trail of all the wrapped
+// errors in the tree are symbolized if needed. If any error in the tree has sensitive details or metadata (see
+// [IsSensitive]) other than [Secret] values, the "Raw" section is omitted, as it would reveal them.
 func SDump(err error) string {
 	if err == nil {
 		return "[nil]"
 	}
 
+	hasSensitive := false
+
+	Walk(err, func(step *WalkStep) bool {
+		for k, v := range maybeGetDetails(step.Err) {
+			hasSensitive = hasSensitive || isRevealedByDump(k, v)
+		}
+
+		if e, ok := step.Err.(*wrappedError); ok { //nolint:errorlint
+			e.getFrames()
+			e.getWrapTrail()
+
+			for k, v := range e.getAllMetadata() {
+				hasSensitive = hasSensitive || isRevealedByDump(fmt.Sprintf("%v", k), v)
+			}
+		}
+
+		return true
+	})
+
 	type dump struct {
 		Summary *Summary
 		Raw     any
 	}
 
-	return strings.TrimSuffix(
-		spewConfig.Sdump(dump{
-			Summary: GetSummary(err, true),
-			Raw:     err,
-		}),
-		"\n")
+	d := dump{
+		Summary: GetSummary(err, true),
+		Raw:     err,
+	}
+
+	if hasSensitive {
+		d.Raw = redactedValue
+	}
+
+	return strings.TrimSuffix(spewConfig.Sdump(d), "\n")
+}
+
+func isRevealedByDump(k string, v any) bool {
+	if _, ok := v.(Secret); ok {
+		return false
+	}
+
+	return !isNil(v) && IsSensitive(k, v)
 }
@@ -1,6 +1,7 @@
 package errorz_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -16,3 +17,20 @@ func TestDump(t *testing.T) {
 	g.Expect(errorz.SDump(fmt.Errorf("e"))).ToNot(BeEmpty())
 	g.Expect(errorz.SDump(errorz.Errorf("e"))).ToNot(BeEmpty())
 }
+
+func TestDump_Frames(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.SDump(errorz.Errorf("e"))).To(ContainSubstring("TestDump_Frames"))
+}
+
+func dumpTestNestedHelper() error {
+	return errorz.Errorf("inner")
+}
+
+func TestDump_NestedFrames(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(errors.Join(fmt.Errorf("e"), dumpTestNestedHelper()))
+	g.Expect(errorz.SDump(err)).To(ContainSubstring("dumpTestNestedHelper"))
+}
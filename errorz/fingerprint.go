@@ -0,0 +1,121 @@
+package errorz
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+)
+
+// ErrorFingerprint can be implemented by errors to override the fingerprint returned by [Fingerprint].
+type ErrorFingerprint interface {
+	// GetErrorFingerprint returns a stable string used to group occurrences of the same error.
+	GetErrorFingerprint() string
+}
+
+type fingerprintMetadataKey int
+
+// FingerprintMetadataKey is a metadata key that can be used with [MaybeSetMetadata] to override the fingerprint
+// returned by [Fingerprint] for a wrapped error with a string value.
+const (
+	FingerprintMetadataKey fingerprintMetadataKey = 0
+)
+
+var (
+	defaultFingerprintMaxFrames = 3
+)
+
+// DefaultFingerprintMaxFrames is the maximum number of frames considered by [Fingerprint].
+var (
+	DefaultFingerprintMaxFrames = defaultFingerprintMaxFrames
+)
+
+// RestoreDefaultFingerprintMaxFrames restores the default value of [DefaultFingerprintMaxFrames].
+func RestoreDefaultFingerprintMaxFrames() {
+	DefaultFingerprintMaxFrames = defaultFingerprintMaxFrames
+}
+
+// Fingerprint returns a stable string that can be used to group occurrences of the same error, or an empty string if
+// err is nil. In order of precedence, it returns:
+//   - the string value of [FingerprintMetadataKey], if set;
+//   - the result of the [ErrorFingerprint] interface, if implemented by any error in the unwrap tree;
+//   - a hash of the error name, the shape of the component tree (names only), and the locations (without line numbers)
+//     of the top [DefaultFingerprintMaxFrames] user code frames of the outermost wrapped error, falling back to the top
+//     frames if none of them are user code.
+//
+// Messages are not considered, as they often contain variable content.
+func Fingerprint(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	if v, ok := MaybeGetMetadata[string](err, FingerprintMetadataKey); ok {
+		return v
+	}
+
+	if e, ok := As[ErrorFingerprint](err); ok {
+		return e.GetErrorFingerprint()
+	}
+
+	s := GetSummaryWithOptions(err, true, &SummaryOptions{
+		IncludeFingerprint: false,
+		Audience:           SummaryAudienceInternal,
+		ServerErrorMessage: "",
+		MessageCatalog:     nil,
+		Language:           "",
+	})
+	h := sha256.New()
+	_, _ = io.WriteString(h, s.Name)
+	_, _ = h.Write([]byte{0})
+	writeFingerprintShape(h, s.Components[0])
+	_, _ = h.Write([]byte{0})
+
+	for _, frame := range getFingerprintFrames(err) {
+		_, _ = io.WriteString(h, frame.Location)
+		_, _ = h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil)[:16])
+}
+
+func writeFingerprintShape(w io.Writer, s *Summary) {
+	_, _ = io.WriteString(w, s.Name)
+	_, _ = io.WriteString(w, "(")
+
+	for i, cS := range s.Components {
+		if i > 0 {
+			_, _ = io.WriteString(w, ",")
+		}
+		writeFingerprintShape(w, cS)
+	}
+
+	_, _ = io.WriteString(w, ")")
+}
+
+func getFingerprintFrames(err error) Frames {
+	var wErr *wrappedError
+
+	walkErrors(err, func(err error) {
+		if e, ok := err.(*wrappedError); ok { //nolint:errorlint
+			wErr = e
+		}
+	})
+
+	if wErr == nil || DefaultFingerprintMaxFrames <= 0 {
+		return nil
+	}
+
+	frames := GetFrames(wErr)
+	userCodeFrames := make(Frames, 0, DefaultFingerprintMaxFrames)
+
+	for _, frame := range frames {
+		if frame.UserCode && len(userCodeFrames) < DefaultFingerprintMaxFrames {
+			userCodeFrames = append(userCodeFrames, frame)
+		}
+	}
+
+	if len(userCodeFrames) > 0 {
+		return userCodeFrames
+	}
+
+	return frames[:min(len(frames), DefaultFingerprintMaxFrames)]
+}
@@ -0,0 +1,70 @@
+package errorz_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+type fingerprintTestError string
+
+func (fingerprintTestError) Error() string {
+	return "fingerprint-test-error"
+}
+
+func (e fingerprintTestError) GetErrorFingerprint() string {
+	return string(e)
+}
+
+func newFingerprintTestError(id int) error {
+	return errorz.NotFoundf("not found: %v", id)
+}
+
+func TestFingerprint(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.Fingerprint(nil)).To(BeEmpty())
+
+	fp := errorz.Fingerprint(newFingerprintTestError(1))
+	g.Expect(fp).To(MatchRegexp("^[0-9a-f]{32}$"))
+	g.Expect(errorz.Fingerprint(newFingerprintTestError(2))).To(Equal(fp))
+	g.Expect(errorz.Fingerprint(errorz.NotFoundf("not found: %v", 1))).ToNot(Equal(fp))
+	g.Expect(errorz.Fingerprint(errorz.Wrap(newFingerprintTestError(3), fmt.Errorf("o")))).ToNot(Equal(fp))
+
+	g.Expect(errorz.Fingerprint(fmt.Errorf("e1"))).To(Equal(errorz.Fingerprint(fmt.Errorf("e2"))))
+	g.Expect(errorz.Fingerprint(fmt.Errorf("e1"))).ToNot(Equal(errorz.Fingerprint(terrorz.TestStringError("e1"))))
+
+	g.Expect(errorz.Fingerprint(fingerprintTestError("fp"))).To(Equal("fp"))
+	g.Expect(errorz.Fingerprint(errors.Join(fmt.Errorf("e"), errorz.Wrap(fingerprintTestError("fp"))))).
+		To(Equal("fp"))
+
+	err := errorz.Wrap(fingerprintTestError("fp"))
+	errorz.MaybeSetMetadata(err, errorz.FingerprintMetadataKey, "md")
+	g.Expect(errorz.Fingerprint(err)).To(Equal("md"))
+}
+
+func TestFingerprint_Frames(t *testing.T) {
+	g := NewWithT(t)
+
+	errs := make([]error, 0, 2)
+	for i := range 2 {
+		errs = append(errs, errorz.Errorf("e%v", i))
+	}
+	g.Expect(errorz.Fingerprint(errs[0])).To(Equal(errorz.Fingerprint(errs[1])))
+
+	newErr := func() error { return errorz.Errorf("e") }
+	g.Expect(errorz.Fingerprint(errorz.Errorf("e"))).ToNot(Equal(errorz.Fingerprint(newErr())))
+
+	errorz.DefaultUserCodePackagePrefixes = []string{"other"}
+	defer errorz.RestoreDefaultUserCodePackagePrefixes()
+	g.Expect(errorz.Fingerprint(errorz.Errorf("e"))).ToNot(Equal(errorz.Fingerprint(newErr())))
+
+	errorz.DefaultFingerprintMaxFrames = 0
+	defer errorz.RestoreDefaultFingerprintMaxFrames()
+	g.Expect(errorz.Fingerprint(errorz.Errorf("e"))).To(Equal(errorz.Fingerprint(newErr())))
+}
@@ -0,0 +1,100 @@
+package errorz
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+const (
+	formatterIndent = "    "
+)
+
+// formatError implements [fmt.Formatter] for the errors in this package:
+//   - "%s" and "%v" print the error message, "%q" prints it quoted;
+//   - "%+v" prints the error message, followed by a tree of components, each with its name, message, HTTP status,
+//     details (redacted as in [SummaryAudienceInternal]), and (for wrapped errors) metadata keys, frames as returned by
+//     [GetFrames], and wrap trail as returned by [GetWrapTrail];
+//   - "%#v" prints the structure of the error as returned by [SDump].
+func formatError(s fmt.State, verb rune, err error) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		w := &strings.Builder{}
+		_, _ = w.WriteString(err.Error())
+		writeFormattedComponent(w, err, 1)
+		_, _ = io.WriteString(s, w.String())
+	case verb == 'v' && s.Flag('#'):
+		_, _ = io.WriteString(s, SDump(err))
+	default:
+		_, _ = fmt.Fprintf(s, fmt.FormatString(s, verb), err.Error())
+	}
+}
+
+func writeFormattedComponent(w *strings.Builder, err error, depth int) {
+	cS := getComponentSummary(err)
+	cS.Details = redactMap(cS.Details, SummaryAudienceInternal)
+	indent := strings.Repeat(formatterIndent, depth)
+	attrIndent := indent + formatterIndent
+
+	switch {
+	case cS.Name != "" && cS.Message != "":
+		_, _ = fmt.Fprintf(w, "\n%v%v: %v", indent, cS.Name, cS.Message)
+	case cS.Name != "":
+		_, _ = fmt.Fprintf(w, "\n%v%v", indent, cS.Name)
+	default:
+		_, _ = fmt.Fprintf(w, "\n%v%v", indent, cS.Message)
+	}
+
+	if cS.HTTPStatus != 0 {
+		_, _ = fmt.Fprintf(w, "\n%vhttpStatus: %v", attrIndent, cS.HTTPStatus)
+	}
+
+	if len(cS.Details) > 0 {
+		_, _ = fmt.Fprintf(w, "\n%vdetails:", attrIndent)
+
+		keys := make([]string, 0, len(cS.Details))
+		for k := range cS.Details {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			_, _ = fmt.Fprintf(w, "\n%v%v%v: %v", attrIndent, formatterIndent, k, cS.Details[k])
+		}
+	}
+
+	if e, ok := err.(*wrappedError); ok { //nolint:errorlint
+		if metadata := e.getAllMetadata(); len(metadata) > 0 {
+			keys := make([]string, 0, len(metadata))
+			for k := range metadata {
+				keys = append(keys, fmt.Sprintf("%T(%v)", k, k))
+			}
+			slices.Sort(keys)
+
+			_, _ = fmt.Fprintf(w, "\n%vmetadata: %v", attrIndent, strings.Join(keys, ", "))
+		}
+
+		if frames := GetFrames(e); len(frames) > 0 {
+			_, _ = fmt.Fprintf(w, "\n%vframes:", attrIndent)
+
+			for _, frame := range frames {
+				_, _ = fmt.Fprintf(w, "\n%v%v%v", attrIndent, formatterIndent, frame.Summary)
+			}
+		}
+
+		if trail := e.getWrapTrail(); len(trail) > 0 {
+			_, _ = fmt.Fprintf(w, "\n%vwrap trail:", attrIndent)
+
+			for _, frame := range trail {
+				_, _ = fmt.Fprintf(w, "\n%v%v%v", attrIndent, formatterIndent, frame.Summary)
+			}
+		}
+	}
+
+	for _, uErr := range Unwrap(err) {
+		if uErr != nil {
+			writeFormattedComponent(w, uErr, depth+1)
+		}
+	}
+}
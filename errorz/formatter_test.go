@@ -0,0 +1,69 @@
+package errorz_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+type formatterTestMetadataKey int
+
+func TestFormat(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(fmt.Errorf("e"), &terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "o1",
+		Name:         "mock",
+		HTTPStatus:   500,
+		Details:      map[string]any{"k2": "v2", "k1": "v1"},
+	})
+	errorz.MaybeSetMetadata(err, formatterTestMetadataKey(1), "v")
+
+	g.Expect(fmt.Sprintf("%v", err)).To(Equal("o1: e"))
+	g.Expect(fmt.Sprintf("%s", err)).To(Equal("o1: e"))
+	g.Expect(fmt.Sprintf("%q", err)).To(Equal(`"o1: e"`))
+	g.Expect(fmt.Sprintf("%8.2s", err)).To(Equal("      o1"))
+	g.Expect(fmt.Sprintf("%d", err)).To(Equal("%!d(string=o1: e)"))
+	g.Expect(fmt.Sprintf("%#v", err)).To(Equal(errorz.SDump(err)))
+
+	lines := strings.Split(fmt.Sprintf("%+v", err), "\n")
+	g.Expect(lines).To(HaveLen(11))
+	g.Expect(lines[:5]).To(HaveExactElements(
+		"o1: e",
+		"    [wrap]",
+		"        metadata: errorz_test.formatterTestMetadataKey(1)",
+		"        frames:",
+		MatchRegexp(`^            errorz_test\.TestFormat \(.+/formatter_test\.go:\d+\)$`)))
+	g.Expect(lines[5:]).To(HaveExactElements(
+		"        e",
+		"        mock: o1",
+		"            httpStatus: 500",
+		"            details:",
+		"                k1: v1",
+		"                k2: v2"))
+}
+
+func TestFormat_Nested(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(errors.Join(errorz.Errorf("e1"), terrorz.TestStringError("e2")))
+	lines := strings.Split(fmt.Sprintf("%+v", err), "\n")
+
+	g.Expect(lines).To(ContainElements(
+		"    [wrap]",
+		"        [join]",
+		"            [wrap]",
+		"                frames:",
+		"                e1",
+		"            terrorz.TestStringError: e2"))
+
+	g.Expect(fmt.Sprintf("%v", errorz.Unwrap(errorz.WrapRecover("v"))[0])).To(Equal("v"))
+	g.Expect(fmt.Sprintf("%+v", errorz.Unwrap(errorz.WrapRecover("v"))[0])).
+		To(Equal("v\n    value-error: v\n        details:\n            value: v"))
+}
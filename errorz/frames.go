@@ -4,9 +4,34 @@ import (
 	"fmt"
 	"path"
 	"runtime"
+	"runtime/debug"
+	"slices"
 	"strings"
 )
 
+var (
+	defaultUserCodePackagePrefixes = getMainModulePackagePrefixes()
+)
+
+// DefaultUserCodePackagePrefixes is a default, shared list of package path prefixes used by [NewFrame] to mark frames
+// as user code. It is initialized to the main module path, as read from the build info.
+var (
+	DefaultUserCodePackagePrefixes = defaultUserCodePackagePrefixes
+)
+
+// RestoreDefaultUserCodePackagePrefixes restores the default value of [DefaultUserCodePackagePrefixes].
+func RestoreDefaultUserCodePackagePrefixes() {
+	DefaultUserCodePackagePrefixes = defaultUserCodePackagePrefixes
+}
+
+func getMainModulePackagePrefixes() []string {
+	if bi, ok := debug.ReadBuildInfo(); ok && bi.Main.Path != "" {
+		return []string{bi.Main.Path}
+	}
+
+	return nil
+}
+
 // Frame describes a frame.
 type Frame struct {
 	Summary       string `json:"summary,omitempty"`
@@ -18,6 +43,7 @@ type Frame struct {
 	FileAndLine   string `json:"fileAndLine,omitempty"`
 	File          string `json:"file,omitempty"`
 	Line          int    `json:"line,omitempty"`
+	UserCode      bool   `json:"userCode,omitempty"`
 }
 
 // NewFrame initializes a new frame.
@@ -38,6 +64,7 @@ func NewFrame(frameFunction, file string, line int) *Frame {
 		FileAndLine:   fileAndLine,
 		File:          file,
 		Line:          line,
+		UserCode:      false,
 	}
 
 	if frameFunction != "" {
@@ -72,6 +99,7 @@ func NewFrame(frameFunction, file string, line int) *Frame {
 		f.Package = base
 		f.ShortPackage = shortPkg
 		f.Function = function
+		f.UserCode = hasPackagePrefix(base, DefaultUserCodePackagePrefixes)
 	}
 
 	if f.Summary == "" {
@@ -95,28 +123,149 @@ func (f Frames) ToSummaries() []string {
 	return summaries
 }
 
-// GetFrames returns the frames from the error, or the current frames the error is not wrapped or is nil.
+// FrameFilter describes rules for filtering frames.
+type FrameFilter struct {
+	// DropPackagePrefixes drops frames whose package path starts with any of the given prefixes (whole path elements).
+	DropPackagePrefixes []string
+	// DropFunctionGlobs drops frames whose location (package path and function) matches any of the given globs, using
+	// [path.Match] syntax.
+	DropFunctionGlobs []string
+	// Drop drops frames for which it returns true, if not nil.
+	Drop func(frame *Frame) bool
+	// CollapsePackages keeps only the first of consecutive frames from the same package.
+	CollapsePackages bool
+	// MaxFrames caps the number of frames, if greater than zero.
+	MaxFrames int
+}
+
+var (
+	defaultFrameFilter = &FrameFilter{
+		DropPackagePrefixes: []string{"runtime", "testing", "reflect", "net/http"},
+		DropFunctionGlobs:   nil,
+		Drop:                nil,
+		CollapsePackages:    false,
+		MaxFrames:           0,
+	}
+)
+
+// DefaultFrameFilter is a default, shared instance of [*FrameFilter], used by [GetFrames].
+var (
+	DefaultFrameFilter = defaultFrameFilter
+)
+
+// RestoreDefaultFrameFilter restores the default value of [DefaultFrameFilter].
+func RestoreDefaultFrameFilter() {
+	DefaultFrameFilter = defaultFrameFilter
+}
+
+// Filter returns the frames that pass the given filter. If filter is nil, the frames are returned unchanged.
+func (f Frames) Filter(filter *FrameFilter) Frames {
+	if filter == nil {
+		return f
+	}
+
+	frames := make(Frames, 0, len(f))
+
+	for _, frame := range f {
+		if filter.drops(frame) {
+			continue
+		}
+
+		if filter.CollapsePackages && len(frames) > 0 && frames[len(frames)-1].Package == frame.Package {
+			continue
+		}
+
+		frames = append(frames, frame)
+
+		if filter.MaxFrames > 0 && len(frames) >= filter.MaxFrames {
+			break
+		}
+	}
+
+	return frames
+}
+
+func (f *FrameFilter) drops(frame *Frame) bool {
+	if hasPackagePrefix(frame.Package, f.DropPackagePrefixes) {
+		return true
+	}
+
+	for _, glob := range f.DropFunctionGlobs {
+		if ok, _ := path.Match(glob, frame.Location); ok {
+			return true
+		}
+	}
+
+	return f.Drop != nil && f.Drop(frame)
+}
+
+func hasPackagePrefix(pkg string, prefixes []string) bool {
+	if pkg == "" {
+		return false
+	}
+
+	return slices.ContainsFunc(prefixes, func(prefix string) bool {
+		prefix = strings.TrimSuffix(prefix, "/")
+		return prefix != "" && (pkg == prefix || strings.HasPrefix(pkg, prefix+"/"))
+	})
+}
+
+// GetFrames returns the frames from the error, or the current frames if the error is not wrapped or is nil. Frames are
+// filtered using [DefaultFrameFilter].
 func GetFrames(err error) Frames {
+	return GetFilteredFrames(err, DefaultFrameFilter)
+}
+
+// GetFilteredFrames is like [GetFrames] but uses the given filter instead of [DefaultFrameFilter]. If filter is nil,
+// only frames from this package are dropped.
+func GetFilteredFrames(err error, filter *FrameFilter) Frames {
 	if e, ok := err.(*wrappedError); ok { //nolint:errorlint
-		return e.frames
+		return e.getFrames().Filter(filter)
 	}
 
-	callers := make([]uintptr, 1024)
-	callers = callers[:runtime.Callers(1, callers[:])]
-	callersFrames := runtime.CallersFrames(callers)
+	return symbolizeCallers(captureCallers()).Filter(filter)
+}
 
-	frames := make([]*Frame, 0, len(callers))
+const (
+	maxStackCallers = 64
+	maxHeapCallers  = 1024
+
+	maxWrapSiteCallers = 16
+)
+
+func captureCallers() []uintptr {
+	var stackCallers [maxStackCallers]uintptr
+
+	if n := runtime.Callers(2, stackCallers[:]); n < maxStackCallers {
+		return slices.Clone(stackCallers[:n])
+	}
+
+	heapCallers := make([]uintptr, maxHeapCallers)
+	return heapCallers[:runtime.Callers(2, heapCallers)]
+}
+
+func captureWrapSite() []uintptr {
+	callers := make([]uintptr, maxWrapSiteCallers)
+	return callers[:runtime.Callers(2, callers)]
+}
+
+func symbolizeCallers(callers []uintptr) Frames {
+	frames := make(Frames, 0, len(callers))
+
+	if len(callers) == 0 {
+		return frames
+	}
+
+	callersFrames := runtime.CallersFrames(callers)
 
 	for {
 		callerFrame, more := callersFrames.Next()
 		frame := NewFrame(callerFrame.Function, callerFrame.File, callerFrame.Line)
 
-		if frame.ShortPackage == "errorz" {
-			continue
+		if frame.ShortPackage != "errorz" {
+			frames = append(frames, frame)
 		}
 
-		frames = append(frames, frame)
-
 		if !more {
 			break
 		}
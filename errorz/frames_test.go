@@ -164,3 +164,91 @@ func TestGetFrames(t *testing.T) {
 	g.Expect(frames).ToNot(BeEmpty())
 	g.Expect(frames[0].ShortLocation).To(Equal("errorz_test.TestGetFrames"))
 }
+
+func TestNewFrame_UserCode(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.DefaultUserCodePackagePrefixes).To(HaveExactElements("github.com/ibrt/golang-utils"))
+	g.Expect(errorz.NewFrame("github.com/ibrt/golang-utils/errorz_test.f", "", 0).UserCode).To(BeTrue())
+	g.Expect(errorz.NewFrame("github.com/ibrt/golang-utils.f", "", 0).UserCode).To(BeTrue())
+	g.Expect(errorz.NewFrame("github.com/ibrt/golang-utils-x/a.f", "", 0).UserCode).To(BeFalse())
+	g.Expect(errorz.NewFrame("net/http.f", "", 0).UserCode).To(BeFalse())
+	g.Expect(errorz.NewFrame("", "f.go", 1).UserCode).To(BeFalse())
+
+	errorz.DefaultUserCodePackagePrefixes = []string{"net/"}
+	defer errorz.RestoreDefaultUserCodePackagePrefixes()
+
+	g.Expect(errorz.NewFrame("github.com/ibrt/golang-utils/errorz_test.f", "", 0).UserCode).To(BeFalse())
+	g.Expect(errorz.NewFrame("net/http.f", "", 0).UserCode).To(BeTrue())
+}
+
+func TestFrames_Filter(t *testing.T) {
+	g := NewWithT(t)
+
+	frames := errorz.Frames{
+		errorz.NewFrame("a/b.f1", "", 0),
+		errorz.NewFrame("a/b.f2", "", 0),
+		errorz.NewFrame("a/bc.f1", "", 0),
+		errorz.NewFrame("c.(*T).f1", "", 0),
+		errorz.NewFrame("c.f2", "", 0),
+		errorz.NewFrame("d.f1", "", 0),
+		errorz.NewFrame("a/b.f3", "", 0),
+	}
+
+	g.Expect(frames.Filter(nil)).To(Equal(frames))
+	g.Expect(frames.Filter(&errorz.FrameFilter{}).ToSummaries()).To(Equal(frames.ToSummaries()))
+
+	g.Expect(frames.Filter(&errorz.FrameFilter{DropPackagePrefixes: []string{"a/b/"}}).ToSummaries()).
+		To(HaveExactElements("bc.f1", "c.(*T).f1", "c.f2", "d.f1"))
+
+	g.Expect(frames.Filter(&errorz.FrameFilter{DropFunctionGlobs: []string{"c.*", "*/b.f[12]"}}).ToSummaries()).
+		To(HaveExactElements("bc.f1", "d.f1", "b.f3"))
+
+	g.Expect(frames.Filter(&errorz.FrameFilter{Drop: func(f *errorz.Frame) bool { return f.Function == "f1" }}).
+		ToSummaries()).
+		To(HaveExactElements("b.f2", "c.(*T).f1", "c.f2", "b.f3"))
+
+	g.Expect(frames.Filter(&errorz.FrameFilter{CollapsePackages: true}).ToSummaries()).
+		To(HaveExactElements("b.f1", "bc.f1", "c.(*T).f1", "d.f1", "b.f3"))
+
+	g.Expect(frames.Filter(&errorz.FrameFilter{CollapsePackages: true, MaxFrames: 2}).ToSummaries()).
+		To(HaveExactElements("b.f1", "bc.f1"))
+}
+
+func TestGetFilteredFrames(t *testing.T) {
+	g := NewWithT(t)
+
+	frames := errorz.GetFilteredFrames(nil, nil)
+	g.Expect(frames[0].ShortLocation).To(Equal("errorz_test.TestGetFilteredFrames"))
+	g.Expect(frames[0].UserCode).To(BeTrue())
+	g.Expect(frames[len(frames)-1].ShortPackage).To(Equal("runtime"))
+
+	frames = errorz.GetFrames(nil)
+	g.Expect(frames).To(HaveLen(1))
+	g.Expect(frames[0].ShortLocation).To(Equal("errorz_test.TestGetFilteredFrames"))
+
+	err := errorz.Errorf("e")
+	g.Expect(errorz.GetFilteredFrames(err, nil)).To(HaveLen(len(errorz.GetFilteredFrames(nil, nil))))
+	g.Expect(errorz.GetFrames(err)).To(HaveLen(1))
+
+	errorz.DefaultFrameFilter = &errorz.FrameFilter{MaxFrames: 2}
+	defer errorz.RestoreDefaultFrameFilter()
+
+	g.Expect(errorz.GetFrames(err)).To(HaveLen(2))
+}
+
+func TestGetFilteredFrames_Deep(t *testing.T) {
+	g := NewWithT(t)
+
+	var recurse func(n int) error
+	recurse = func(n int) error {
+		if n == 0 {
+			return errorz.Errorf("e")
+		}
+		return recurse(n - 1)
+	}
+
+	err := recurse(100)
+	g.Expect(len(errorz.GetFilteredFrames(err, nil))).To(BeNumerically(">", 100))
+	g.Expect(errorz.GetFilteredFrames(err, nil)).To(Equal(errorz.GetFilteredFrames(err, nil)))
+}
@@ -0,0 +1,92 @@
+package errorz
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// Group runs functions in separate goroutines using [Catch0Ctx], so that panics are converted to errors. It is similar
+// to "golang.org/x/sync/errgroup.Group": it supports a concurrency limit and cancels the shared context on the first
+// failure. Unlike errgroup, [Group.Wait] returns all the errors, not only the first one.
+type Group struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	wg     *sync.WaitGroup
+	m      *sync.Mutex
+	sem    chan struct{}
+	errs   []error
+}
+
+// NewGroup initializes a new [*Group], returning it along with a derived [context.Context] which is canceled the first
+// time a function returns an error (or panics), or when [Group.Wait] returns.
+func NewGroup(ctx context.Context) (*Group, context.Context) {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	return &Group{
+		ctx:    ctx,
+		cancel: cancel,
+		wg:     &sync.WaitGroup{},
+		m:      &sync.Mutex{},
+		sem:    nil,
+		errs:   nil,
+	}, ctx
+}
+
+// SetLimit limits the number of functions running concurrently to n, or removes the limit if n <= 0. It must be called
+// before the first call to [Group.Go].
+func (g *Group) SetLimit(n int) *Group {
+	if n <= 0 {
+		g.sem = nil
+		return g
+	}
+
+	g.sem = make(chan struct{}, n)
+	return g
+}
+
+// Go runs f in a new goroutine, blocking until the concurrency limit (if any) allows it.
+func (g *Group) Go(f func(ctx context.Context) error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+
+	g.wg.Add(1)
+
+	go func() {
+		defer func() {
+			if g.sem != nil {
+				<-g.sem
+			}
+
+			g.wg.Done()
+		}()
+
+		if err := Catch0Ctx(g.ctx, f); err != nil {
+			g.m.Lock()
+			defer g.m.Unlock()
+
+			if len(g.errs) == 0 {
+				g.cancel(err)
+			}
+
+			g.errs = append(g.errs, err)
+		}
+	}()
+}
+
+// Wait blocks until all functions have returned, then returns a wrapped error joining all their errors (in order of
+// completion), or nil if all of them succeeded.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel(nil)
+
+	g.m.Lock()
+	defer g.m.Unlock()
+
+	if len(g.errs) == 0 {
+		return nil
+	}
+
+	return Wrap(errors.Join(g.errs...))
+}
@@ -0,0 +1,102 @@
+package errorz_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+func TestGroup_Success(t *testing.T) {
+	g := NewWithT(t)
+
+	grp, ctx := errorz.NewGroup(context.Background())
+	count := &atomic.Int32{}
+
+	for range 10 {
+		grp.Go(func(_ context.Context) error {
+			count.Add(1)
+			return nil
+		})
+	}
+
+	g.Expect(grp.Wait()).To(Succeed())
+	g.Expect(count.Load()).To(Equal(int32(10)))
+	g.Expect(ctx.Err()).To(MatchError(context.Canceled))
+	g.Expect(context.Cause(ctx)).To(MatchError(context.Canceled))
+}
+
+func TestGroup_Errors(t *testing.T) {
+	g := NewWithT(t)
+
+	grp, ctx := errorz.NewGroup(context.Background())
+	grp.SetLimit(1)
+
+	grp.Go(func(_ context.Context) error {
+		return errorz.Errorf("e1")
+	})
+
+	grp.Go(func(ctx context.Context) error {
+		<-ctx.Done()
+		panic("e2")
+	})
+
+	grp.Go(func(_ context.Context) error {
+		return nil
+	})
+
+	err := grp.Wait()
+	g.Expect(err).To(MatchError("e1\ne2"))
+	g.Expect(context.Cause(ctx)).To(MatchError("e1"))
+
+	s := errorz.GetSummary(err, true)
+	g.Expect(s.Components[0].Name).To(Equal("[wrap]"))
+	g.Expect(s.Components[0].Components[0].Name).To(Equal("[join]"))
+	g.Expect(s.Components[0].Components[0].Components).To(HaveLen(2))
+	g.Expect(s.Components[0].Components[0].Components[1].Components[0].Name).To(Equal("value-error"))
+
+	errs := errorz.Unwrap(errorz.Unwrap(err)[0])
+	g.Expect(errs).To(HaveLen(2))
+	g.Expect(strings.Join(errorz.GetFrames(errs[1]).ToSummaries(), "\n")).To(ContainSubstring("TestGroup_Errors"))
+}
+
+func TestGroup_Limit(t *testing.T) {
+	g := NewWithT(t)
+
+	grp, _ := errorz.NewGroup(context.Background())
+	g.Expect(grp.SetLimit(0)).To(BeIdenticalTo(grp))
+	grp.SetLimit(2)
+
+	current := &atomic.Int32{}
+	maxCurrent := &atomic.Int32{}
+
+	for i := range 10 {
+		grp.Go(func(_ context.Context) error {
+			c := current.Add(1)
+			defer current.Add(-1)
+
+			for {
+				m := maxCurrent.Load()
+				if c <= m || maxCurrent.CompareAndSwap(m, c) {
+					break
+				}
+			}
+
+			time.Sleep(time.Millisecond)
+
+			if i%5 == 0 {
+				return fmt.Errorf("e%v", i)
+			}
+			return nil
+		})
+	}
+
+	g.Expect(grp.Wait()).To(MatchError(And(ContainSubstring("e0"), ContainSubstring("e5"))))
+	g.Expect(maxCurrent.Load()).To(BeNumerically("<=", 2))
+}
@@ -0,0 +1,3 @@
+// Package grpcz converts errors to and from gRPC statuses, and provides server interceptors which convert the errors
+// (and panics) returned by handlers to statuses.
+package grpcz
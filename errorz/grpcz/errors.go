@@ -0,0 +1,176 @@
+package grpcz
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+var (
+	kindsByCode = map[codes.Code]*errorz.Kind{
+		codes.Canceled:           errorz.KindCanceled,
+		codes.Unknown:            errorz.KindUnknown,
+		codes.InvalidArgument:    errorz.KindInvalidArgument,
+		codes.DeadlineExceeded:   errorz.KindDeadlineExceeded,
+		codes.NotFound:           errorz.KindNotFound,
+		codes.AlreadyExists:      errorz.KindAlreadyExists,
+		codes.PermissionDenied:   errorz.KindPermissionDenied,
+		codes.ResourceExhausted:  errorz.KindResourceExhausted,
+		codes.FailedPrecondition: errorz.KindFailedPrecondition,
+		codes.Aborted:            errorz.KindAborted,
+		codes.OutOfRange:         errorz.KindOutOfRange,
+		codes.Unimplemented:      errorz.KindUnimplemented,
+		codes.Internal:           errorz.KindInternal,
+		codes.Unavailable:        errorz.KindUnavailable,
+		codes.DataLoss:           errorz.KindDataLoss,
+		codes.Unauthenticated:    errorz.KindUnauthenticated,
+	}
+)
+
+func getKindForCode(code codes.Code) (*errorz.Kind, bool) {
+	k, ok := kindsByCode[code]
+	return k, ok
+}
+
+// FromStatus converts a [*status.Status] to a wrapped [*StatusError], or returns nil if s is nil or OK.
+func FromStatus(s *status.Status) error {
+	if s == nil || s.Code() == codes.OK {
+		return nil
+	}
+
+	return errorz.Wrap(newStatusError(s))
+}
+
+// FromError is like [FromStatus] but accepts an error returned by a gRPC client. If the error does not wrap a status,
+// it is wrapped and returned as is.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if s, ok := status.FromError(err); ok {
+		return FromStatus(s)
+	}
+
+	return errorz.Wrap(err)
+}
+
+var (
+	_ error                  = (*StatusError)(nil)
+	_ errorz.ErrorName       = (*StatusError)(nil)
+	_ errorz.ErrorHTTPStatus = (*StatusError)(nil)
+	_ errorz.ErrorDetails    = (*StatusError)(nil)
+	_ errorz.ErrorKind       = (*StatusError)(nil)
+	_ grpcStatus             = (*StatusError)(nil)
+)
+
+// StatusError describes an error converted from a [*status.Status]. The name is the reason of the first
+// "google.rpc.ErrorInfo" detail message, or the name of the [*errorz.Kind] matching the code, or "status-error" for
+// unknown codes. The details are the metadata of the first "google.rpc.ErrorInfo" detail message, plus the field
+// violations of the first "google.rpc.BadRequest" detail message (if any) under [FieldsDetailsKey].
+type StatusError struct {
+	s       *status.Status
+	name    string
+	kind    *errorz.Kind
+	details map[string]any
+}
+
+func newStatusError(s *status.Status) *StatusError {
+	e := &StatusError{
+		s:       s,
+		name:    "status-error",
+		kind:    nil,
+		details: nil,
+	}
+
+	if k, ok := getKindForCode(s.Code()); ok {
+		e.kind = k
+		e.name = k.GetName()
+	}
+
+	var hasErrorInfo, hasBadRequest bool
+
+	for _, d := range s.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			if hasErrorInfo {
+				continue
+			}
+
+			hasErrorInfo = true
+
+			if d.GetReason() != "" {
+				e.name = d.GetReason()
+			}
+
+			for k, v := range d.GetMetadata() {
+				e.setDetail(k, v)
+			}
+		case *errdetails.BadRequest:
+			if hasBadRequest {
+				continue
+			}
+
+			hasBadRequest = true
+			fields := make(map[string]any, len(d.GetFieldViolations()))
+
+			for _, fv := range d.GetFieldViolations() {
+				fields[fv.GetField()] = fv.GetDescription()
+			}
+
+			e.setDetail(FieldsDetailsKey, fields)
+		}
+	}
+
+	return e
+}
+
+func (e *StatusError) setDetail(k string, v any) {
+	if e.details == nil {
+		e.details = make(map[string]any)
+	}
+
+	e.details[k] = v
+}
+
+// Error implements the error interface.
+func (e *StatusError) Error() string {
+	return e.s.Message()
+}
+
+// GetErrorName implements the [errorz.ErrorName] interface.
+func (e *StatusError) GetErrorName() string {
+	return e.name
+}
+
+// GetErrorHTTPStatus implements the [errorz.ErrorHTTPStatus] interface.
+func (e *StatusError) GetErrorHTTPStatus() int {
+	if e.kind != nil {
+		return e.kind.GetHTTPStatus()
+	}
+
+	return 0
+}
+
+// GetErrorDetails implements the [errorz.ErrorDetails] interface.
+func (e *StatusError) GetErrorDetails() map[string]any {
+	return e.details
+}
+
+// GetErrorKind implements the [errorz.ErrorKind] interface.
+func (e *StatusError) GetErrorKind() *errorz.Kind {
+	return e.kind
+}
+
+// GRPCStatus returns the status the error was converted from, so that it is returned as is by [ToStatus] and by
+// "google.golang.org/grpc/status.FromError".
+func (e *StatusError) GRPCStatus() *status.Status {
+	return e.s
+}
+
+// GetCode returns the code of the status the error was converted from.
+func (e *StatusError) GetCode() codes.Code {
+	return e.s.Code()
+}
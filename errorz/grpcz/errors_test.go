@@ -0,0 +1,121 @@
+package grpcz_test
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/grpcz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+func TestFromStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(grpcz.FromStatus(nil)).To(Succeed())
+	g.Expect(grpcz.FromStatus(status.New(codes.OK, ""))).To(Succeed())
+
+	s := status.New(codes.NotFound, "user not found")
+	err := grpcz.FromStatus(s)
+	g.Expect(err).To(MatchError("user not found"))
+	g.Expect(errorz.GetFrames(err)).ToNot(BeEmpty())
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindNotFound))
+	g.Expect(errorz.GetSummary(err, false)).To(Equal(&errorz.Summary{
+		Name:       "not-found",
+		Message:    "user not found",
+		HTTPStatus: 404,
+		Details:    map[string]any{},
+	}))
+
+	sErr, ok := errorz.As[*grpcz.StatusError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(sErr.GetCode()).To(Equal(codes.NotFound))
+	g.Expect(sErr.GRPCStatus()).To(BeIdenticalTo(s))
+	g.Expect(grpcz.ToStatus(err)).To(BeIdenticalTo(s))
+
+	err = grpcz.FromStatus(status.New(codes.Code(100), "e"))
+	g.Expect(errorz.GetSummary(err, false)).To(Equal(&errorz.Summary{
+		Name:       "status-error",
+		Message:    "e",
+		HTTPStatus: 0,
+		Details:    map[string]any{},
+	}))
+}
+
+func TestFromStatus_Details(t *testing.T) {
+	g := NewWithT(t)
+
+	s, err := status.New(codes.InvalidArgument, "validation failed").WithDetails(
+		&errdetails.ErrorInfo{
+			Reason:   "validation-error",
+			Domain:   "example.com",
+			Metadata: map[string]string{"k": "v"},
+		},
+		&errdetails.ErrorInfo{
+			Reason: "other",
+		},
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "a", Description: "required"},
+			},
+		},
+		&errdetails.BadRequest{},
+	)
+	g.Expect(err).To(Succeed())
+
+	err = grpcz.FromStatus(s)
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindInvalidArgument))
+	g.Expect(errorz.GetSummary(err, false)).To(Equal(&errorz.Summary{
+		Name:       "validation-error",
+		Message:    "validation failed",
+		HTTPStatus: 400,
+		Details: map[string]any{
+			"k":      "v",
+			"fields": map[string]any{"a": "required"},
+		},
+	}))
+}
+
+func TestRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	err := grpcz.FromStatus(grpcz.ToStatus(errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "validation failed",
+		Name:         "validation-error",
+		HTTPStatus:   400,
+		Details: map[string]any{
+			"fields": map[string]any{"a": "required"},
+			"k":      "v",
+		},
+	})))
+
+	g.Expect(errorz.GetSummary(err, false)).To(Equal(&errorz.Summary{
+		Name:       "validation-error",
+		Message:    "validation failed",
+		HTTPStatus: 400,
+		Details: map[string]any{
+			"k":      "v",
+			"fields": map[string]any{"a": "required"},
+		},
+	}))
+}
+
+func TestFromError(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(grpcz.FromError(nil)).To(Succeed())
+
+	err := grpcz.FromError(status.Error(codes.Unavailable, "e"))
+	g.Expect(err).To(MatchError("e"))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindUnavailable))
+
+	e := fmt.Errorf("e")
+	err = grpcz.FromError(e)
+	g.Expect(err).To(MatchError(e))
+	g.Expect(errorz.GetFrames(err)).ToNot(BeEmpty())
+}
@@ -0,0 +1,61 @@
+package grpcz
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+// NewUnaryServerInterceptor returns a [grpc.UnaryServerInterceptor] which runs handlers using [errorz.Catch1Ctx] and
+// converts the returned errors to statuses using [ToStatus]. Panics are converted to "Internal" statuses and
+// logged (including frames) using the given logger, or [slog.Default] if nil.
+func NewUnaryServerInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return runHandler(ctx, logger, info.FullMethod, func(ctx context.Context) (any, error) {
+			return handler(ctx, req)
+		})
+	}
+}
+
+// NewStreamServerInterceptor is like [NewUnaryServerInterceptor] but returns a [grpc.StreamServerInterceptor].
+func NewStreamServerInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		_, err := runHandler(ss.Context(), logger, info.FullMethod, func(_ context.Context) (any, error) {
+			return nil, handler(srv, ss)
+		})
+		return err
+	}
+}
+
+func runHandler(
+	ctx context.Context,
+	logger *slog.Logger,
+	method string,
+	f func(ctx context.Context) (any, error),
+) (any, error) {
+	isPanic := true
+
+	resp, err := errorz.Catch1Ctx(ctx, func(ctx context.Context) (any, error) {
+		resp, err := f(ctx)
+		isPanic = false
+		return resp, err
+	})
+
+	if err == nil {
+		return resp, nil
+	}
+
+	if isPanic {
+		if logger == nil {
+			logger = slog.Default()
+		}
+
+		logger.ErrorContext(ctx, "gRPC handler panicked", slog.String("method", method), slog.Any("error", err))
+		err = errorz.KindInternal.Wrap(err)
+	}
+
+	return nil, ToStatus(err).Err()
+}
@@ -0,0 +1,186 @@
+package grpcz_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/grpcz"
+)
+
+type interceptorsTestHandler func(ctx context.Context, req string) (string, error)
+
+func newInterceptorsTestClient(t *testing.T, handler interceptorsTestHandler, logger *slog.Logger) *grpc.ClientConn {
+	g := NewWithT(t)
+	lis := bufconn.Listen(1024 * 1024)
+
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(grpcz.NewUnaryServerInterceptor(logger)),
+		grpc.ChainStreamInterceptor(grpcz.NewStreamServerInterceptor(logger)))
+
+	srv.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "test.Test",
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "Unary",
+				Handler: func(
+					_ any,
+					ctx context.Context,
+					dec func(any) error,
+					interceptor grpc.UnaryServerInterceptor,
+				) (any, error) {
+					req := &wrapperspb.StringValue{}
+					if err := dec(req); err != nil {
+						return nil, err
+					}
+
+					info := &grpc.UnaryServerInfo{
+						Server:     nil,
+						FullMethod: "/test.Test/Unary",
+					}
+
+					return interceptor(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+						resp, err := handler(ctx, req.(*wrapperspb.StringValue).GetValue())
+						if err != nil {
+							return nil, err
+						}
+						return wrapperspb.String(resp), nil
+					})
+				},
+			},
+		},
+		Streams: []grpc.StreamDesc{
+			{
+				StreamName: "Stream",
+				Handler: func(_ any, stream grpc.ServerStream) error {
+					req := &wrapperspb.StringValue{}
+					if err := stream.RecvMsg(req); err != nil {
+						return err
+					}
+
+					resp, err := handler(stream.Context(), req.GetValue())
+					if err != nil {
+						return err
+					}
+
+					return stream.SendMsg(wrapperspb.String(resp))
+				},
+				ServerStreams: true,
+				ClientStreams: true,
+			},
+		},
+		Metadata: nil,
+	}, nil)
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	g.Expect(err).To(Succeed())
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		srv.Stop()
+	})
+
+	return conn
+}
+
+func invokeUnary(conn *grpc.ClientConn, req string) (string, error) {
+	resp := &wrapperspb.StringValue{}
+	err := conn.Invoke(context.Background(), "/test.Test/Unary", wrapperspb.String(req), resp)
+	return resp.GetValue(), err
+}
+
+func invokeStream(conn *grpc.ClientConn, req string) (string, error) {
+	stream, err := conn.NewStream(
+		context.Background(),
+		&grpc.StreamDesc{StreamName: "Stream", ServerStreams: true, ClientStreams: true},
+		"/test.Test/Stream")
+	if err != nil {
+		return "", err
+	}
+
+	if err := stream.SendMsg(wrapperspb.String(req)); err != nil {
+		return "", err
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return "", err
+	}
+
+	resp := &wrapperspb.StringValue{}
+	err = stream.RecvMsg(resp)
+	return resp.GetValue(), err
+}
+
+func testInterceptorsHandler(_ context.Context, req string) (string, error) {
+	switch req {
+	case "not-found":
+		return "", errorz.NotFoundf("user not found")
+	case "status":
+		return "", status.Error(codes.AlreadyExists, "exists")
+	case "panic":
+		panic("handler panic")
+	default:
+		return "resp: " + req, nil
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	for _, invoke := range []func(conn *grpc.ClientConn, req string) (string, error){invokeUnary, invokeStream} {
+		g := NewWithT(t)
+		buf := &bytes.Buffer{}
+		logger := slog.New(slog.NewJSONHandler(buf, nil))
+		conn := newInterceptorsTestClient(t, testInterceptorsHandler, logger)
+
+		resp, err := invoke(conn, "ok")
+		g.Expect(err).To(Succeed())
+		g.Expect(resp).To(Equal("resp: ok"))
+
+		_, err = invoke(conn, "not-found")
+		g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+		g.Expect(status.Convert(err).Message()).To(Equal("user not found"))
+
+		fErr := grpcz.FromError(err)
+		g.Expect(errorz.KindOf(fErr)).To(BeIdenticalTo(errorz.KindNotFound))
+		g.Expect(errorz.GetSummary(fErr, false).Name).To(Equal("not-found"))
+
+		_, err = invoke(conn, "status")
+		g.Expect(status.Code(err)).To(Equal(codes.AlreadyExists))
+		g.Expect(status.Convert(err).Message()).To(Equal("exists"))
+		g.Expect(buf.String()).To(BeEmpty())
+
+		_, err = invoke(conn, "panic")
+		g.Expect(status.Code(err)).To(Equal(codes.Internal))
+		g.Expect(status.Convert(err).Message()).To(Equal("internal error"))
+		g.Expect(buf.String()).To(ContainSubstring(`"msg":"gRPC handler panicked"`))
+		g.Expect(buf.String()).To(ContainSubstring(`"method":"/test.Test/`))
+		g.Expect(buf.String()).To(ContainSubstring(`testInterceptorsHandler`))
+	}
+}
+
+func TestInterceptors_DefaultLogger(t *testing.T) {
+	g := NewWithT(t)
+	conn := newInterceptorsTestClient(t, testInterceptorsHandler, nil)
+
+	_, err := invokeUnary(conn, "panic")
+	g.Expect(status.Code(err)).To(Equal(codes.Internal))
+}
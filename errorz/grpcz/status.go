@@ -0,0 +1,242 @@
+package grpcz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+// FieldsDetailsKey is the key of the error details converted to (and from) "google.rpc.BadRequest" field violations.
+// Its value is expected to be a map from field name to description, like the one in "vldz.ValidationError" details.
+const (
+	FieldsDetailsKey = "fields"
+)
+
+// StatusOptions describes options for converting errors to gRPC statuses.
+type StatusOptions struct {
+	// Domain is the domain of the "google.rpc.ErrorInfo" detail messages.
+	Domain string
+	// SummaryOptions are the options used to obtain the error summary, or nil for [errorz.DefaultSummaryOptions].
+	SummaryOptions *errorz.SummaryOptions
+}
+
+var (
+	defaultStatusOptions = &StatusOptions{
+		Domain: "",
+		SummaryOptions: &errorz.SummaryOptions{
+			IncludeFingerprint: false,
+			Audience:           errorz.SummaryAudiencePublic,
+			ServerErrorMessage: "internal error",
+			MessageCatalog:     nil,
+			Language:           "",
+		},
+	}
+)
+
+// DefaultStatusOptions is a default, shared instance of [*StatusOptions], used by [ToStatus]. It replaces the messages
+// of server errors (5xx) with "internal error".
+var (
+	DefaultStatusOptions = defaultStatusOptions
+)
+
+// RestoreDefaultStatusOptions restores the default value of [DefaultStatusOptions].
+func RestoreDefaultStatusOptions() {
+	DefaultStatusOptions = defaultStatusOptions
+}
+
+type grpcStatus interface {
+	GRPCStatus() *status.Status
+}
+
+// ToStatus converts an error to a [*status.Status], using [DefaultStatusOptions].
+func ToStatus(err error) *status.Status {
+	return ToStatusWithOptions(err, DefaultStatusOptions)
+}
+
+// ToStatusWithOptions converts an error to a [*status.Status]:
+//   - nil errors are converted to an OK status;
+//   - errors wrapping a status (i.e. implementing "GRPCStatus() *status.Status") are converted to that status;
+//   - otherwise, the code is obtained from [GetCode], the message and details from [errorz.GetSummaryWithOptions].
+//
+// The summary name and details (except [FieldsDetailsKey]) are included as an "google.rpc.ErrorInfo" detail message,
+// and [FieldsDetailsKey] as a "google.rpc.BadRequest" detail message. If opts is nil, [DefaultStatusOptions] is used.
+func ToStatusWithOptions(err error, opts *StatusOptions) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	if e, ok := errorz.As[grpcStatus](err); ok {
+		if s := e.GRPCStatus(); s != nil {
+			return s
+		}
+	}
+
+	if opts == nil {
+		opts = DefaultStatusOptions
+	}
+
+	sum := errorz.GetSummaryWithOptions(err, false, opts.SummaryOptions)
+	code := GetCode(err)
+	s := status.New(code, sum.Message)
+
+	details := make([]protoadapt.MessageV1, 0, 2)
+	details = append(details, newErrorInfo(sum, code, opts.Domain))
+
+	if br := newBadRequest(sum); br != nil {
+		details = append(details, br)
+	}
+
+	if sWithDetails, dErr := s.WithDetails(details...); dErr == nil {
+		return sWithDetails
+	}
+
+	return s
+}
+
+// GetCode returns the gRPC code for an error. In order of precedence, it considers:
+//   - the code of the status wrapped by the error, if any;
+//   - the code of the [*errorz.Kind] returned by [errorz.KindOf], if any;
+//   - context cancellation and deadline errors;
+//   - the HTTP status from [errorz.GetSummary], mapped to the closest code;
+//   - the presence of [FieldsDetailsKey] in the error details, which maps to [codes.InvalidArgument].
+//
+// It returns [codes.OK] for nil errors and [codes.Unknown] if none of the above applies.
+func GetCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+
+	if e, ok := errorz.As[grpcStatus](err); ok {
+		if s := e.GRPCStatus(); s != nil {
+			return s.Code()
+		}
+	}
+
+	if k := errorz.KindOf(err); k != nil {
+		return codes.Code(k.GetGRPCCode())
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+
+	sum := errorz.GetSummary(err, false)
+
+	if code := getCodeForHTTPStatus(sum.HTTPStatus); code != codes.Unknown {
+		return code
+	}
+
+	if _, ok := sum.Details[FieldsDetailsKey]; ok {
+		return codes.InvalidArgument
+	}
+
+	return codes.Unknown
+}
+
+func getCodeForHTTPStatus(httpStatus int) codes.Code {
+	switch {
+	case httpStatus == http.StatusBadRequest:
+		return codes.InvalidArgument
+	case httpStatus == http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case httpStatus == http.StatusForbidden:
+		return codes.PermissionDenied
+	case httpStatus == http.StatusNotFound:
+		return codes.NotFound
+	case httpStatus == http.StatusConflict:
+		return codes.Aborted
+	case httpStatus == http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case httpStatus == 499:
+		return codes.Canceled
+	case httpStatus == http.StatusNotImplemented:
+		return codes.Unimplemented
+	case httpStatus == http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case httpStatus == http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case httpStatus >= 400 && httpStatus < 500:
+		return codes.FailedPrecondition
+	case httpStatus >= 500 && httpStatus < 600:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
+
+func newErrorInfo(sum *errorz.Summary, code codes.Code, domain string) *errdetails.ErrorInfo {
+	info := &errdetails.ErrorInfo{
+		Reason:   sum.Name,
+		Domain:   domain,
+		Metadata: make(map[string]string, len(sum.Details)),
+	}
+
+	if info.Reason == "" {
+		if k, ok := getKindForCode(code); ok {
+			info.Reason = k.GetName()
+		}
+	}
+
+	for k, v := range sum.Details {
+		if k != FieldsDetailsKey {
+			info.Metadata[k] = stringify(v)
+		}
+	}
+
+	return info
+}
+
+func newBadRequest(sum *errorz.Summary) *errdetails.BadRequest {
+	var fields map[string]string
+
+	switch v := sum.Details[FieldsDetailsKey].(type) {
+	case map[string]any:
+		fields = make(map[string]string, len(v))
+		for k, vv := range v {
+			fields[k] = stringify(vv)
+		}
+	case map[string]string:
+		fields = v
+	default:
+		return nil
+	}
+
+	br := &errdetails.BadRequest{
+		FieldViolations: make([]*errdetails.BadRequest_FieldViolation, 0, len(fields)),
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(fields)) {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       k,
+			Description: fields[k],
+		})
+	}
+
+	return br
+}
+
+func stringify(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+
+	if buf, err := json.Marshal(v); err == nil {
+		return string(buf)
+	}
+
+	return fmt.Sprint(v)
+}
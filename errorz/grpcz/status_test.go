@@ -0,0 +1,156 @@
+package grpcz_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/grpcz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+func TestToStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	s := grpcz.ToStatus(nil)
+	g.Expect(s.Code()).To(Equal(codes.OK))
+	g.Expect(s.Err()).To(Succeed())
+
+	s = grpcz.ToStatus(errorz.NotFoundf("user not found"))
+	g.Expect(s.Code()).To(Equal(codes.NotFound))
+	g.Expect(s.Message()).To(Equal("user not found"))
+	g.Expect(s.Details()).To(HaveLen(1))
+
+	info, ok := s.Details()[0].(*errdetails.ErrorInfo)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(info.GetReason()).To(Equal("not-found"))
+	g.Expect(info.GetDomain()).To(Equal(""))
+	g.Expect(info.GetMetadata()).To(BeEmpty())
+
+	s = grpcz.ToStatus(fmt.Errorf("e"))
+	g.Expect(s.Code()).To(Equal(codes.Unknown))
+	g.Expect(s.Details()[0].(*errdetails.ErrorInfo).GetReason()).To(Equal("unknown"))
+}
+
+func TestToStatus_Details(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "validation failed",
+		Name:         "validation-error",
+		HTTPStatus:   0,
+		Details: map[string]any{
+			"fields":   map[string]any{"b": "required", "a": "email"},
+			"k1":       "v1",
+			"k2":       map[string]any{"x": 1},
+			"password": "secret",
+		},
+	})
+
+	s := grpcz.ToStatus(err)
+	g.Expect(s.Code()).To(Equal(codes.InvalidArgument))
+	g.Expect(s.Message()).To(Equal("validation failed"))
+	g.Expect(s.Details()).To(HaveLen(2))
+
+	info, ok := s.Details()[0].(*errdetails.ErrorInfo)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(info.GetReason()).To(Equal("validation-error"))
+	g.Expect(info.GetMetadata()).To(Equal(map[string]string{"k1": "v1", "k2": `{"x":1}`}))
+
+	br, ok := s.Details()[1].(*errdetails.BadRequest)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(br.GetFieldViolations()).To(HaveLen(2))
+	g.Expect(br.GetFieldViolations()[0].GetField()).To(Equal("a"))
+	g.Expect(br.GetFieldViolations()[0].GetDescription()).To(Equal("email"))
+	g.Expect(br.GetFieldViolations()[1].GetField()).To(Equal("b"))
+
+	s = grpcz.ToStatus(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e",
+		Details:      map[string]any{"fields": map[string]string{"a": "required"}},
+	})
+	g.Expect(s.Details()[1].(*errdetails.BadRequest).GetFieldViolations()[0].GetDescription()).To(Equal("required"))
+}
+
+func TestToStatusWithOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	s := grpcz.ToStatusWithOptions(errorz.Internalf("db error"), &grpcz.StatusOptions{
+		Domain: "example.com",
+		SummaryOptions: &errorz.SummaryOptions{
+			ServerErrorMessage: "internal error",
+		},
+	})
+	g.Expect(s.Code()).To(Equal(codes.Internal))
+	g.Expect(s.Message()).To(Equal("internal error"))
+	g.Expect(s.Details()[0].(*errdetails.ErrorInfo).GetDomain()).To(Equal("example.com"))
+
+	s = grpcz.ToStatusWithOptions(errorz.Internalf("db error"), nil)
+	g.Expect(s.Message()).To(Equal("internal error"))
+
+	s = grpcz.ToStatusWithOptions(errorz.Internalf("db error"), &grpcz.StatusOptions{
+		SummaryOptions: &errorz.SummaryOptions{
+			Audience: errorz.SummaryAudiencePublic,
+		},
+	})
+	g.Expect(s.Message()).To(Equal("db error"))
+}
+
+func TestDefaultStatusOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	defer grpcz.RestoreDefaultStatusOptions()
+	grpcz.DefaultStatusOptions = &grpcz.StatusOptions{Domain: "example.com"}
+	g.Expect(grpcz.ToStatus(errorz.Errorf("e")).Details()[0].(*errdetails.ErrorInfo).GetDomain()).To(Equal("example.com"))
+
+	grpcz.RestoreDefaultStatusOptions()
+	g.Expect(grpcz.ToStatus(errorz.Errorf("e")).Details()[0].(*errdetails.ErrorInfo).GetDomain()).To(Equal(""))
+}
+
+func TestToStatus_Passthrough(t *testing.T) {
+	g := NewWithT(t)
+
+	s := status.New(codes.AlreadyExists, "exists")
+	g.Expect(grpcz.ToStatus(errorz.Wrap(s.Err(), fmt.Errorf("outer")))).To(BeIdenticalTo(s))
+	g.Expect(grpcz.GetCode(errorz.Wrap(s.Err()))).To(Equal(codes.AlreadyExists))
+}
+
+func TestGetCode(t *testing.T) {
+	type testCase struct {
+		err  error
+		code codes.Code
+	}
+
+	for i, tc := range []testCase{
+		{nil, codes.OK},
+		{fmt.Errorf("e"), codes.Unknown},
+		{errorz.PermissionDeniedf("e"), codes.PermissionDenied},
+		{errorz.Wrap(context.Canceled), codes.Canceled},
+		{fmt.Errorf("e: %w", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 400}, codes.InvalidArgument},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 401}, codes.Unauthenticated},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 403}, codes.PermissionDenied},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 404}, codes.NotFound},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 409}, codes.Aborted},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 418}, codes.FailedPrecondition},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 429}, codes.ResourceExhausted},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 499}, codes.Canceled},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 500}, codes.Internal},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 501}, codes.Unimplemented},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 503}, codes.Unavailable},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 504}, codes.DeadlineExceeded},
+		{&terrorz.SimpleMockTestDetailedError{HTTPStatus: 302}, codes.Unknown},
+		{&terrorz.SimpleMockTestDetailedError{Name: "not-found"}, codes.NotFound},
+		{&terrorz.SimpleMockTestDetailedError{Details: map[string]any{"fields": nil}}, codes.InvalidArgument},
+	} {
+		t.Run(fmt.Sprintf("%03v", i+1), func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(grpcz.GetCode(tc.err)).To(Equal(tc.code))
+		})
+	}
+}
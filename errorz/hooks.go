@@ -0,0 +1,101 @@
+package errorz
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// HookType describes the event that caused a [Hook] to be called.
+type HookType int
+
+// Known hook types.
+const (
+	// HookTypeWrap is used when [Wrap] (or a related function) creates a new wrapped error.
+	HookTypeWrap HookType = 0
+	// HookTypeRecover is used when [WrapRecover] (or a related function) converts a recovered value to an error.
+	HookTypeRecover HookType = 1
+	// HookTypeCatch is used when a "Catch*" function returns an error.
+	HookTypeCatch HookType = 2
+)
+
+// Hook is a function called on certain events involving errors, e.g. for metrics and tracing. It receives the wrapped
+// error and a function returning its frames as returned by [GetFrames], so that frames are only symbolized if needed.
+// Hooks are called synchronously, so they should be fast, and they must not wrap errors themselves (or they would be
+// called recursively).
+type Hook func(hookType HookType, err error, getFrames func() Frames)
+
+type namedHook struct {
+	name string
+	hook Hook
+}
+
+var (
+	hooksM = &sync.Mutex{}
+	hooks  = &atomic.Pointer[[]*namedHook]{}
+)
+
+// RegisterHook registers a [Hook] with the given name, replacing any existing one. Hooks are called in order of
+// registration.
+func RegisterHook(name string, hook Hook) {
+	hooksM.Lock()
+	defer hooksM.Unlock()
+
+	var newHooks []*namedHook
+
+	if currentHooks := hooks.Load(); currentHooks != nil {
+		newHooks = slices.Clone(*currentHooks)
+	}
+
+	nh := &namedHook{
+		name: name,
+		hook: hook,
+	}
+
+	if i := slices.IndexFunc(newHooks, func(h *namedHook) bool { return h.name == name }); i >= 0 {
+		newHooks[i] = nh
+	} else {
+		newHooks = append(newHooks, nh)
+	}
+
+	hooks.Store(&newHooks)
+}
+
+// UnregisterHook unregisters the [Hook] with the given name, if any.
+func UnregisterHook(name string) {
+	hooksM.Lock()
+	defer hooksM.Unlock()
+
+	currentHooks := hooks.Load()
+	if currentHooks == nil {
+		return
+	}
+
+	newHooks := slices.DeleteFunc(slices.Clone(*currentHooks), func(h *namedHook) bool { return h.name == name })
+
+	if len(newHooks) == 0 {
+		hooks.Store(nil)
+		return
+	}
+
+	hooks.Store(&newHooks)
+}
+
+func maybeRunHooks(hookType HookType, err error) {
+	if err == nil {
+		return
+	}
+
+	currentHooks := hooks.Load()
+	if currentHooks == nil {
+		return
+	}
+
+	getFrames := func() Frames {
+		return GetFrames(err)
+	}
+
+	for _, h := range *currentHooks {
+		h.hook(hookType, err, getFrames)
+	}
+}
@@ -0,0 +1,139 @@
+package errorz_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+type hookTestCall struct {
+	name     string
+	hookType errorz.HookType
+	err      error
+	frames   errorz.Frames
+}
+
+type hookTestRecorder struct {
+	m     *sync.Mutex
+	calls []*hookTestCall
+}
+
+func newHookTestRecorder() *hookTestRecorder {
+	return &hookTestRecorder{
+		m:     &sync.Mutex{},
+		calls: nil,
+	}
+}
+
+func (r *hookTestRecorder) newHook(name string) errorz.Hook {
+	return func(hookType errorz.HookType, err error, getFrames func() errorz.Frames) {
+		r.m.Lock()
+		defer r.m.Unlock()
+
+		r.calls = append(r.calls, &hookTestCall{
+			name:     name,
+			hookType: hookType,
+			err:      err,
+			frames:   getFrames(),
+		})
+	}
+}
+
+func (r *hookTestRecorder) popCalls() []*hookTestCall {
+	r.m.Lock()
+	defer r.m.Unlock()
+
+	calls := r.calls
+	r.calls = nil
+	return calls
+}
+
+func TestHooks(t *testing.T) {
+	g := NewWithT(t)
+	r := newHookTestRecorder()
+
+	errorz.RegisterHook("h1", r.newHook("h1"))
+	defer errorz.UnregisterHook("h1")
+	errorz.RegisterHook("h2", r.newHook("h2"))
+	defer errorz.UnregisterHook("h2")
+
+	err := errorz.Errorf("e")
+	calls := r.popCalls()
+	g.Expect(calls).To(HaveLen(2))
+	g.Expect(calls[0].name).To(Equal("h1"))
+	g.Expect(calls[0].hookType).To(Equal(errorz.HookTypeWrap))
+	g.Expect(calls[0].err).To(BeIdenticalTo(err))
+	g.Expect(calls[0].frames[0].ShortLocation).To(Equal("errorz_test.TestHooks"))
+	g.Expect(calls[1].name).To(Equal("h2"))
+
+	errorz.Wrap(err, fmt.Errorf("o"))
+	g.Expect(r.popCalls()).To(BeEmpty())
+
+	errorz.RegisterHook("h1", r.newHook("h1b"))
+	errorz.UnregisterHook("h2")
+	errorz.UnregisterHook("h3")
+
+	err = errorz.WrapRecover("p")
+	calls = r.popCalls()
+	g.Expect(calls).To(HaveLen(1))
+	g.Expect(calls[0].name).To(Equal("h1b"))
+	g.Expect(calls[0].hookType).To(Equal(errorz.HookTypeRecover))
+	g.Expect(calls[0].err).To(BeIdenticalTo(err))
+	g.Expect(calls[0].frames[0].ShortLocation).To(Equal("errorz_test.TestHooks"))
+
+	err = errorz.WrapRecover(fmt.Errorf("p"))
+	calls = r.popCalls()
+	g.Expect(calls).To(HaveLen(1))
+	g.Expect(calls[0].hookType).To(Equal(errorz.HookTypeRecover))
+	g.Expect(calls[0].err).To(BeIdenticalTo(err))
+
+	g.Expect(errorz.Catch0(func() error { return nil })).To(Succeed())
+	g.Expect(r.popCalls()).To(BeEmpty())
+
+	err = errorz.Catch0(func() error { return fmt.Errorf("e") })
+	calls = r.popCalls()
+	g.Expect(calls).To(HaveLen(2))
+	g.Expect(calls[0].hookType).To(Equal(errorz.HookTypeWrap))
+	g.Expect(calls[1].hookType).To(Equal(errorz.HookTypeCatch))
+	g.Expect(calls[1].err).To(BeIdenticalTo(err))
+
+	_, _, _, err = errorz.Catch3(func() (int, int, int, error) { panic("p") })
+	calls = r.popCalls()
+	g.Expect(calls).To(HaveLen(2))
+	g.Expect(calls[0].hookType).To(Equal(errorz.HookTypeRecover))
+	g.Expect(calls[1].hookType).To(Equal(errorz.HookTypeCatch))
+	g.Expect(calls[1].err).To(BeIdenticalTo(err))
+
+	errorz.UnregisterHook("h1")
+	errorz.Errorf("e")
+	g.Expect(r.popCalls()).To(BeEmpty())
+}
+
+func TestHooks_Concurrent(t *testing.T) {
+	g := NewWithT(t)
+	r := newHookTestRecorder()
+	wg := &sync.WaitGroup{}
+
+	for i := range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			name := fmt.Sprintf("concurrent-%v", i)
+			errorz.RegisterHook(name, r.newHook(name))
+			_ = errorz.Errorf("e")
+			errorz.UnregisterHook(name)
+		}()
+	}
+
+	wg.Wait()
+	g.Expect(len(r.popCalls())).To(BeNumerically(">=", 10))
+
+	_ = errorz.Errorf("e")
+	g.Expect(r.popCalls()).To(BeEmpty())
+}
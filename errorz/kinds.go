@@ -0,0 +1,338 @@
+package errorz
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// GRPCCode describes a gRPC status code. Values match the ones in "google.golang.org/grpc/codes".
+type GRPCCode uint32
+
+// Known gRPC codes.
+const (
+	GRPCCodeOK                 GRPCCode = 0
+	GRPCCodeCanceled           GRPCCode = 1
+	GRPCCodeUnknown            GRPCCode = 2
+	GRPCCodeInvalidArgument    GRPCCode = 3
+	GRPCCodeDeadlineExceeded   GRPCCode = 4
+	GRPCCodeNotFound           GRPCCode = 5
+	GRPCCodeAlreadyExists      GRPCCode = 6
+	GRPCCodePermissionDenied   GRPCCode = 7
+	GRPCCodeResourceExhausted  GRPCCode = 8
+	GRPCCodeFailedPrecondition GRPCCode = 9
+	GRPCCodeAborted            GRPCCode = 10
+	GRPCCodeOutOfRange         GRPCCode = 11
+	GRPCCodeUnimplemented      GRPCCode = 12
+	GRPCCodeInternal           GRPCCode = 13
+	GRPCCodeUnavailable        GRPCCode = 14
+	GRPCCodeDataLoss           GRPCCode = 15
+	GRPCCodeUnauthenticated    GRPCCode = 16
+)
+
+// ErrorKind can be implemented by errors to associate a [*Kind] to themselves.
+type ErrorKind interface {
+	// GetErrorKind returns the [*Kind] associated with an error.
+	GetErrorKind() *Kind
+}
+
+var (
+	kindsM                     = &sync.RWMutex{}
+	kinds                      = make(map[string]*Kind)
+	kindsFramesCaptureOffCount = &atomic.Int64{}
+)
+
+// Kind describes a canonical kind of error, with a default HTTP status and gRPC code.
+type Kind struct {
+	name               string
+	httpStatus         int
+	grpcCode           GRPCCode
+	isFramesCaptureOff atomic.Bool
+}
+
+// Canonical kinds.
+var (
+	KindCanceled          = MustRegisterKind("canceled", 499, GRPCCodeCanceled)
+	KindUnknown           = MustRegisterKind("unknown", http.StatusInternalServerError, GRPCCodeUnknown)
+	KindInvalidArgument   = MustRegisterKind("invalid-argument", http.StatusBadRequest, GRPCCodeInvalidArgument)
+	KindDeadlineExceeded  = MustRegisterKind("deadline-exceeded", http.StatusGatewayTimeout, GRPCCodeDeadlineExceeded)
+	KindNotFound          = MustRegisterKind("not-found", http.StatusNotFound, GRPCCodeNotFound)
+	KindAlreadyExists     = MustRegisterKind("already-exists", http.StatusConflict, GRPCCodeAlreadyExists)
+	KindPermissionDenied  = MustRegisterKind("permission-denied", http.StatusForbidden, GRPCCodePermissionDenied)
+	KindResourceExhausted = MustRegisterKind(
+		"resource-exhausted", http.StatusTooManyRequests, GRPCCodeResourceExhausted)
+	KindFailedPrecondition = MustRegisterKind("failed-precondition", http.StatusBadRequest, GRPCCodeFailedPrecondition)
+	KindAborted            = MustRegisterKind("aborted", http.StatusConflict, GRPCCodeAborted)
+	KindOutOfRange         = MustRegisterKind("out-of-range", http.StatusBadRequest, GRPCCodeOutOfRange)
+	KindUnimplemented      = MustRegisterKind("unimplemented", http.StatusNotImplemented, GRPCCodeUnimplemented)
+	KindInternal           = MustRegisterKind("internal", http.StatusInternalServerError, GRPCCodeInternal)
+	KindUnavailable        = MustRegisterKind("unavailable", http.StatusServiceUnavailable, GRPCCodeUnavailable)
+	KindDataLoss           = MustRegisterKind("data-loss", http.StatusInternalServerError, GRPCCodeDataLoss)
+	KindUnauthenticated    = MustRegisterKind("unauthenticated", http.StatusUnauthorized, GRPCCodeUnauthenticated)
+)
+
+// MustRegisterKind registers a new [*Kind], panics if a kind with the same name is already registered.
+func MustRegisterKind(name string, httpStatus int, grpcCode GRPCCode) *Kind {
+	Assertf(name != "", "name is empty")
+
+	kindsM.Lock()
+	defer kindsM.Unlock()
+
+	_, ok := kinds[name]
+	Assertf(!ok, "kind already registered: %v", name)
+
+	k := &Kind{
+		name:               name,
+		httpStatus:         httpStatus,
+		grpcCode:           grpcCode,
+		isFramesCaptureOff: atomic.Bool{},
+	}
+
+	kinds[name] = k
+	return k
+}
+
+// UnregisterKind unregisters the [*Kind] with the given name, if any. Existing references to it remain valid, but it
+// is no longer returned by [GetKind] or matched by name in [KindOf], and its name can be registered again. Frames
+// capture is re-enabled for the unregistered kind (see [Kind.SetFramesCapture]).
+func UnregisterKind(name string) {
+	kindsM.Lock()
+	defer kindsM.Unlock()
+
+	if k, ok := kinds[name]; ok {
+		k.SetFramesCapture(true)
+		delete(kinds, name)
+	}
+}
+
+// GetKind returns the registered [*Kind] with the given name, if any.
+func GetKind(name string) (*Kind, bool) {
+	kindsM.RLock()
+	defer kindsM.RUnlock()
+
+	k, ok := kinds[name]
+	return k, ok
+}
+
+// GetName returns the name of the kind.
+func (k *Kind) GetName() string {
+	return k.name
+}
+
+// GetHTTPStatus returns the default HTTP status of the kind.
+func (k *Kind) GetHTTPStatus() int {
+	return k.httpStatus
+}
+
+// GetGRPCCode returns the default gRPC code of the kind.
+func (k *Kind) GetGRPCCode() GRPCCode {
+	return k.grpcCode
+}
+
+// SetFramesCapture enables or disables capturing frames when wrapping errors of this kind, as determined by [KindOf].
+// Disabling it can save some CPU time on hot paths where errors are expected (e.g. validation). It returns the kind for
+// chaining.
+func (k *Kind) SetFramesCapture(enabled bool) *Kind {
+	if k.isFramesCaptureOff.CompareAndSwap(enabled, !enabled) {
+		if enabled {
+			kindsFramesCaptureOffCount.Add(-1)
+		} else {
+			kindsFramesCaptureOffCount.Add(1)
+		}
+	}
+
+	return k
+}
+
+// IsFramesCapture returns true if frames are captured when wrapping errors of this kind.
+func (k *Kind) IsFramesCapture() bool {
+	return !k.isFramesCaptureOff.Load()
+}
+
+// Errorf creates an error of this kind and wraps it.
+func (k *Kind) Errorf(format string, a ...any) error {
+	return Wrap(&kindError{
+		kind: k,
+		err:  fmt.Errorf(format, a...),
+	})
+}
+
+// Wrap wraps the given error, adding an outer error of this kind.
+func (k *Kind) Wrap(err error) error {
+	return Wrap(err, &kindError{
+		kind: k,
+		err:  nil,
+	})
+}
+
+// Matches returns true if [KindOf] the given error is this kind.
+func (k *Kind) Matches(err error) bool {
+	return KindOf(err) == k
+}
+
+// KindOf returns the [*Kind] of the error, or nil if it does not have one. It walks the unwrap tree the same way as
+// [GetSummary], considering errors implementing [ErrorKind] and errors implementing [ErrorName] with the name of a
+// registered kind. Like for the HTTP status, outer errors take precedence over inner ones.
+func KindOf(err error) *Kind {
+	var k *Kind
+
+	walkErrors(err, func(err error) {
+		if e, ok := err.(ErrorKind); ok { //nolint:errorlint
+			if ek := e.GetErrorKind(); ek != nil {
+				k = ek
+				return
+			}
+		}
+
+		if e, ok := err.(ErrorName); ok { //nolint:errorlint
+			if ek, ok := GetKind(e.GetErrorName()); ok {
+				k = ek
+			}
+		}
+	})
+
+	return k
+}
+
+func shouldCaptureFrames(err error, outerErrs []error) bool {
+	if kindsFramesCaptureOffCount.Load() == 0 {
+		return true
+	}
+
+	k := KindOf(err)
+
+	for _, outerErr := range outerErrs {
+		if outerKind := KindOf(outerErr); outerKind != nil {
+			k = outerKind
+		}
+	}
+
+	return k == nil || k.IsFramesCapture()
+}
+
+// Canceledf creates an error of kind [KindCanceled].
+func Canceledf(format string, a ...any) error {
+	return KindCanceled.Errorf(format, a...)
+}
+
+// Unknownf creates an error of kind [KindUnknown].
+func Unknownf(format string, a ...any) error {
+	return KindUnknown.Errorf(format, a...)
+}
+
+// InvalidArgumentf creates an error of kind [KindInvalidArgument].
+func InvalidArgumentf(format string, a ...any) error {
+	return KindInvalidArgument.Errorf(format, a...)
+}
+
+// DeadlineExceededf creates an error of kind [KindDeadlineExceeded].
+func DeadlineExceededf(format string, a ...any) error {
+	return KindDeadlineExceeded.Errorf(format, a...)
+}
+
+// NotFoundf creates an error of kind [KindNotFound].
+func NotFoundf(format string, a ...any) error {
+	return KindNotFound.Errorf(format, a...)
+}
+
+// AlreadyExistsf creates an error of kind [KindAlreadyExists].
+func AlreadyExistsf(format string, a ...any) error {
+	return KindAlreadyExists.Errorf(format, a...)
+}
+
+// PermissionDeniedf creates an error of kind [KindPermissionDenied].
+func PermissionDeniedf(format string, a ...any) error {
+	return KindPermissionDenied.Errorf(format, a...)
+}
+
+// ResourceExhaustedf creates an error of kind [KindResourceExhausted].
+func ResourceExhaustedf(format string, a ...any) error {
+	return KindResourceExhausted.Errorf(format, a...)
+}
+
+// FailedPreconditionf creates an error of kind [KindFailedPrecondition].
+func FailedPreconditionf(format string, a ...any) error {
+	return KindFailedPrecondition.Errorf(format, a...)
+}
+
+// Abortedf creates an error of kind [KindAborted].
+func Abortedf(format string, a ...any) error {
+	return KindAborted.Errorf(format, a...)
+}
+
+// OutOfRangef creates an error of kind [KindOutOfRange].
+func OutOfRangef(format string, a ...any) error {
+	return KindOutOfRange.Errorf(format, a...)
+}
+
+// Unimplementedf creates an error of kind [KindUnimplemented].
+func Unimplementedf(format string, a ...any) error {
+	return KindUnimplemented.Errorf(format, a...)
+}
+
+// Internalf creates an error of kind [KindInternal].
+func Internalf(format string, a ...any) error {
+	return KindInternal.Errorf(format, a...)
+}
+
+// Unavailablef creates an error of kind [KindUnavailable].
+func Unavailablef(format string, a ...any) error {
+	return KindUnavailable.Errorf(format, a...)
+}
+
+// DataLossf creates an error of kind [KindDataLoss].
+func DataLossf(format string, a ...any) error {
+	return KindDataLoss.Errorf(format, a...)
+}
+
+// Unauthenticatedf creates an error of kind [KindUnauthenticated].
+func Unauthenticatedf(format string, a ...any) error {
+	return KindUnauthenticated.Errorf(format, a...)
+}
+
+var (
+	_ error           = (*kindError)(nil)
+	_ ErrorName       = (*kindError)(nil)
+	_ ErrorHTTPStatus = (*kindError)(nil)
+	_ ErrorKind       = (*kindError)(nil)
+	_ UnwrapMulti     = (*kindError)(nil)
+)
+
+type kindError struct {
+	kind *Kind
+	err  error
+}
+
+// Error implements the error interface.
+func (e *kindError) Error() string {
+	if e.err != nil {
+		return e.err.Error()
+	}
+
+	return e.kind.name
+}
+
+// GetErrorName implements the [ErrorName] interface.
+func (e *kindError) GetErrorName() string {
+	return e.kind.name
+}
+
+// GetErrorHTTPStatus implements the [ErrorHTTPStatus] interface.
+func (e *kindError) GetErrorHTTPStatus() int {
+	return e.kind.httpStatus
+}
+
+// GetErrorKind implements the [ErrorKind] interface.
+func (e *kindError) GetErrorKind() *Kind {
+	return e.kind
+}
+
+// Unwrap implements the [UnwrapMulti] interface.
+func (e *kindError) Unwrap() []error {
+	if e == nil {
+		return nil
+	}
+
+	return Unwrap(e.err)
+}
@@ -0,0 +1,178 @@
+package errorz_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+type kindTestError struct {
+	kind *errorz.Kind
+}
+
+func (*kindTestError) Error() string {
+	return "kind-test-error"
+}
+
+func (e *kindTestError) GetErrorKind() *errorz.Kind {
+	return e.kind
+}
+
+func TestKinds(t *testing.T) {
+	type testCase struct {
+		f          func(format string, a ...any) error
+		kind       *errorz.Kind
+		name       string
+		httpStatus int
+		grpcCode   errorz.GRPCCode
+	}
+
+	for i, tc := range []testCase{
+		{errorz.Canceledf, errorz.KindCanceled, "canceled", 499, errorz.GRPCCodeCanceled},
+		{errorz.Unknownf, errorz.KindUnknown, "unknown", 500, errorz.GRPCCodeUnknown},
+		{errorz.InvalidArgumentf, errorz.KindInvalidArgument, "invalid-argument", 400, errorz.GRPCCodeInvalidArgument},
+		{errorz.DeadlineExceededf, errorz.KindDeadlineExceeded, "deadline-exceeded", 504, errorz.GRPCCodeDeadlineExceeded},
+		{errorz.NotFoundf, errorz.KindNotFound, "not-found", 404, errorz.GRPCCodeNotFound},
+		{errorz.AlreadyExistsf, errorz.KindAlreadyExists, "already-exists", 409, errorz.GRPCCodeAlreadyExists},
+		{errorz.PermissionDeniedf, errorz.KindPermissionDenied, "permission-denied", 403, errorz.GRPCCodePermissionDenied},
+		{errorz.ResourceExhaustedf, errorz.KindResourceExhausted, "resource-exhausted", 429, errorz.GRPCCodeResourceExhausted},
+		{errorz.FailedPreconditionf, errorz.KindFailedPrecondition, "failed-precondition", 400, errorz.GRPCCodeFailedPrecondition},
+		{errorz.Abortedf, errorz.KindAborted, "aborted", 409, errorz.GRPCCodeAborted},
+		{errorz.OutOfRangef, errorz.KindOutOfRange, "out-of-range", 400, errorz.GRPCCodeOutOfRange},
+		{errorz.Unimplementedf, errorz.KindUnimplemented, "unimplemented", 501, errorz.GRPCCodeUnimplemented},
+		{errorz.Internalf, errorz.KindInternal, "internal", 500, errorz.GRPCCodeInternal},
+		{errorz.Unavailablef, errorz.KindUnavailable, "unavailable", 503, errorz.GRPCCodeUnavailable},
+		{errorz.DataLossf, errorz.KindDataLoss, "data-loss", 500, errorz.GRPCCodeDataLoss},
+		{errorz.Unauthenticatedf, errorz.KindUnauthenticated, "unauthenticated", 401, errorz.GRPCCodeUnauthenticated},
+	} {
+		t.Run(fmt.Sprintf("%03v", i+1), func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(tc.kind.GetName()).To(Equal(tc.name))
+			g.Expect(tc.kind.GetHTTPStatus()).To(Equal(tc.httpStatus))
+			g.Expect(tc.kind.GetGRPCCode()).To(Equal(tc.grpcCode))
+
+			k, ok := errorz.GetKind(tc.name)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(k).To(BeIdenticalTo(tc.kind))
+
+			err := tc.f("e: %v", "v")
+			g.Expect(err).To(MatchError("e: v"))
+			g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(tc.kind))
+			g.Expect(tc.kind.Matches(err)).To(BeTrue())
+
+			g.Expect(errorz.GetSummary(err, false)).To(Equal(&errorz.Summary{
+				Name:       tc.name,
+				Message:    "e: v",
+				HTTPStatus: tc.httpStatus,
+				Details:    map[string]any{},
+			}))
+		})
+	}
+}
+
+func TestMustRegisterKind(t *testing.T) {
+	g := NewWithT(t)
+
+	name := "test-kind-register"
+
+	k := errorz.MustRegisterKind(name, 418, errorz.GRPCCodeUnknown)
+	defer errorz.UnregisterKind(name)
+
+	g.Expect(k.GetName()).To(Equal(name))
+	g.Expect(k.GetHTTPStatus()).To(Equal(418))
+	g.Expect(k.GetGRPCCode()).To(Equal(errorz.GRPCCodeUnknown))
+
+	g.Expect(func() { errorz.MustRegisterKind(name, 418, errorz.GRPCCodeUnknown) }).
+		To(PanicWith(MatchError("kind already registered: " + name)))
+
+	g.Expect(func() { errorz.MustRegisterKind("", 418, errorz.GRPCCodeUnknown) }).
+		To(PanicWith(MatchError("name is empty")))
+
+	_, ok := errorz.GetKind("unregistered-kind")
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestUnregisterKind(t *testing.T) {
+	g := NewWithT(t)
+
+	k := errorz.MustRegisterKind("test-kind-unregister", 418, errorz.GRPCCodeUnknown).SetFramesCapture(false)
+	g.Expect(errorz.KindOf(&terrorz.SimpleMockTestDetailedError{Name: "test-kind-unregister"})).To(BeIdenticalTo(k))
+	g.Expect(errorz.GetFrames(k.Errorf("e"))).To(BeEmpty())
+
+	errorz.UnregisterKind("test-kind-unregister")
+	g.Expect(k.IsFramesCapture()).To(BeTrue())
+	g.Expect(errorz.GetFrames(k.Errorf("e"))).ToNot(BeEmpty())
+	_, ok := errorz.GetKind("test-kind-unregister")
+	g.Expect(ok).To(BeFalse())
+	g.Expect(errorz.KindOf(&terrorz.SimpleMockTestDetailedError{Name: "test-kind-unregister"})).To(BeNil())
+	g.Expect(errorz.KindOf(&kindTestError{kind: k})).To(BeIdenticalTo(k))
+
+	errorz.UnregisterKind("test-kind-unregister")
+
+	k2 := errorz.MustRegisterKind("test-kind-unregister", 418, errorz.GRPCCodeUnknown)
+	defer errorz.UnregisterKind("test-kind-unregister")
+	g.Expect(k2).ToNot(BeIdenticalTo(k))
+}
+
+func TestKind_Wrap(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.KindNotFound.Wrap(fmt.Errorf("e"))
+	g.Expect(err).To(MatchError("not-found: e"))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindNotFound))
+	g.Expect(errorz.GetSummary(err, false).HTTPStatus).To(Equal(404))
+
+	err = errorz.KindUnavailable.Wrap(err)
+	g.Expect(err).To(MatchError("unavailable: not-found: e"))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindUnavailable))
+	g.Expect(errorz.GetSummary(err, false).HTTPStatus).To(Equal(503))
+}
+
+func TestKindOf(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.KindOf(nil)).To(BeNil())
+	g.Expect(errorz.KindOf(fmt.Errorf("e"))).To(BeNil())
+	g.Expect(errorz.KindOf(errorz.Errorf("e"))).To(BeNil())
+	g.Expect(errorz.KindOf(&kindTestError{kind: nil})).To(BeNil())
+	g.Expect(errorz.KindOf(&kindTestError{kind: errorz.KindAborted})).To(BeIdenticalTo(errorz.KindAborted))
+
+	g.Expect(errorz.KindOf(&terrorz.SimpleMockTestDetailedError{Name: "not-found"})).
+		To(BeIdenticalTo(errorz.KindNotFound))
+	g.Expect(errorz.KindOf(&terrorz.SimpleMockTestDetailedError{Name: "other"})).
+		To(BeNil())
+
+	g.Expect(errorz.KindOf(errors.Join(errorz.NotFoundf("e1"), fmt.Errorf("e2: %w", errorz.Internalf("e3"))))).
+		To(BeIdenticalTo(errorz.KindInternal))
+
+	g.Expect(errorz.KindOf(errorz.NotFoundf("e1: %w", errorz.Internalf("e2")))).
+		To(BeIdenticalTo(errorz.KindNotFound))
+}
+
+func TestKind_SetFramesCapture(t *testing.T) {
+	g := NewWithT(t)
+
+	k := errorz.MustRegisterKind("test-kind-frames-capture", 400, errorz.GRPCCodeUnknown)
+	defer errorz.UnregisterKind("test-kind-frames-capture")
+	g.Expect(k.IsFramesCapture()).To(BeTrue())
+	g.Expect(errorz.GetFrames(k.Errorf("e"))).ToNot(BeEmpty())
+
+	g.Expect(k.SetFramesCapture(false)).To(BeIdenticalTo(k))
+	defer k.SetFramesCapture(true)
+	g.Expect(k.IsFramesCapture()).To(BeFalse())
+	g.Expect(errorz.GetFrames(k.Errorf("e"))).To(BeEmpty())
+	g.Expect(errorz.GetFrames(errorz.Wrap(fmt.Errorf("e"), &kindTestError{kind: k}))).To(BeEmpty())
+	g.Expect(errorz.GetFrames(k.Wrap(errorz.NotFoundf("e")))).ToNot(BeEmpty())
+	g.Expect(errorz.GetFrames(errorz.NotFoundf("e"))).ToNot(BeEmpty())
+
+	k.SetFramesCapture(false)
+	k.SetFramesCapture(true)
+	g.Expect(k.IsFramesCapture()).To(BeTrue())
+	g.Expect(errorz.GetFrames(k.Errorf("e"))).ToNot(BeEmpty())
+}
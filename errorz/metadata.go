@@ -1,5 +1,66 @@
 package errorz
 
+// MetadataExport describes whether and how a [*MetadataKey] is exported by [GetSummary].
+type MetadataExport int
+
+// Known metadata exports.
+const (
+	// MetadataExportNone does not export the metadata.
+	MetadataExportNone MetadataExport = 0
+	// MetadataExportDetails exports the metadata into [Summary.Details].
+	MetadataExportDetails MetadataExport = 1
+	// MetadataExportMetadata exports the metadata into [Summary.Metadata].
+	MetadataExportMetadata MetadataExport = 2
+)
+
+type exportableMetadataKey interface {
+	getName() string
+	getExport() MetadataExport
+}
+
+var (
+	_ exportableMetadataKey = (*MetadataKey[any])(nil)
+)
+
+// MetadataKey is a typed metadata key. Each instance is a distinct key, regardless of its name.
+type MetadataKey[T any] struct {
+	name   string
+	export MetadataExport
+}
+
+// NewMetadataKey initializes a new [*MetadataKey]. The name is used when exporting the metadata and for debugging.
+func NewMetadataKey[T any](name string, export MetadataExport) *MetadataKey[T] {
+	Assertf(name != "", "name is empty")
+
+	return &MetadataKey[T]{
+		name:   name,
+		export: export,
+	}
+}
+
+// String implements the [fmt.Stringer] interface.
+func (k *MetadataKey[T]) String() string {
+	return k.name
+}
+
+// Set is like [MaybeSetMetadata] but typed.
+func (k *MetadataKey[T]) Set(err error, v T) {
+	MaybeSetMetadata(err, k, v)
+}
+
+// Get is like [MaybeGetMetadata] but typed.
+func (k *MetadataKey[T]) Get(err error) (T, bool) {
+	return MaybeGetMetadata[T](err, k)
+}
+
+func (k *MetadataKey[T]) getName() string {
+	return k.name
+}
+
+func (k *MetadataKey[T]) getExport() MetadataExport {
+	return k.export
+}
+
 // MaybeSetMetadata sets the given metadata (k, v) on the error if it has been wrapped, does nothing otherwise.
 func MaybeSetMetadata(err error, k, v any) {
 	if e, ok := err.(*wrappedError); ok { //nolint:errorlint
@@ -7,21 +68,64 @@ func MaybeSetMetadata(err error, k, v any) {
 	}
 }
 
-// MustGetMetadata gets the given metadata key from the error, panics if not found or wrong type.
+// MustGetMetadata is like [MaybeGetMetadata] but panics if not found or wrong type.
 func MustGetMetadata[T any](err error, k any) T {
-	return err.(*wrappedError).metadata[k].(T) //nolint:errorlint,forcetypeassert
+	v, ok := MaybeGetMetadata[T](err, k)
+	Assertf(ok, "metadata not found or wrong type: %v", k)
+	return v
 }
 
-// MaybeGetMetadata tries to get the given metadata key from the error.
+// MaybeGetMetadata tries to get the given metadata key from the error. Wrapped errors are searched depth-first,
+// starting from the outermost one, and the first value found for the key is returned (if it has the right type).
 func MaybeGetMetadata[T any](err error, k any) (T, bool) {
-	if e, ok := err.(*wrappedError); ok { //nolint:errorlint
-		if m, ok := e.getMetadata(k); ok {
-			if v, ok := m.(T); ok {
-				return v, true
-			}
+	if m, ok := findMetadata(err, k); ok {
+		if v, ok := m.(T); ok {
+			return v, true
 		}
 	}
 
 	var v T
 	return v, false
 }
+
+func findMetadata(err error, k any) (any, bool) {
+	var m any
+	var found bool
+
+	Walk(err, func(step *WalkStep) bool {
+		if e, ok := step.Err.(*wrappedError); ok { //nolint:errorlint
+			m, found = e.getMetadata(k)
+		}
+		return !found
+	})
+
+	return m, found
+}
+
+func getExportedMetadata(err error) (map[string]any, map[string]any) {
+	e, ok := err.(*wrappedError) //nolint:errorlint
+	if !ok {
+		return nil, nil
+	}
+
+	var details, metadata map[string]any
+
+	for k, v := range e.getAllMetadata() {
+		if eK, ok := k.(exportableMetadataKey); ok {
+			switch eK.getExport() {
+			case MetadataExportDetails:
+				if details == nil {
+					details = make(map[string]any)
+				}
+				details[eK.getName()] = v
+			case MetadataExportMetadata:
+				if metadata == nil {
+					metadata = make(map[string]any)
+				}
+				metadata[eK.getName()] = v
+			}
+		}
+	}
+
+	return details, metadata
+}
@@ -1,6 +1,7 @@
 package errorz_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -49,3 +50,83 @@ func TestMetadata(t *testing.T) {
 		g.Expect(func() { errorz.MustGetMetadata[string](err, 0) }).To(Panic())
 	}
 }
+
+func TestMetadata_Nested(t *testing.T) {
+	g := NewWithT(t)
+
+	type mk int
+	const k mk = 0
+
+	inner := errorz.Errorf("inner")
+	errorz.MaybeSetMetadata(inner, k, "v1")
+	err := errorz.Wrap(errors.Join(fmt.Errorf("e"), inner), fmt.Errorf("outer"))
+
+	v, ok := errorz.MaybeGetMetadata[string](err, k)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(v).To(Equal("v1"))
+	g.Expect(errorz.MustGetMetadata[string](err, k)).To(Equal("v1"))
+
+	errorz.MaybeSetMetadata(err, k, 2)
+	_, ok = errorz.MaybeGetMetadata[string](err, k)
+	g.Expect(ok).To(BeFalse())
+	g.Expect(errorz.MustGetMetadata[int](err, k)).To(Equal(2))
+	g.Expect(func() { errorz.MustGetMetadata[string](err, k) }).
+		To(PanicWith(MatchError("metadata not found or wrong type: 0")))
+}
+
+func TestMetadataKey(t *testing.T) {
+	g := NewWithT(t)
+
+	k1 := errorz.NewMetadataKey[string]("k", errorz.MetadataExportNone)
+	k2 := errorz.NewMetadataKey[int]("k", errorz.MetadataExportNone)
+	g.Expect(k1.String()).To(Equal("k"))
+	g.Expect(func() { errorz.NewMetadataKey[string]("", errorz.MetadataExportNone) }).
+		To(PanicWith(MatchError("name is empty")))
+
+	err := fmt.Errorf("e")
+	k1.Set(err, "v")
+	_, ok := k1.Get(err)
+	g.Expect(ok).To(BeFalse())
+
+	err = errorz.Errorf("e")
+	k1.Set(err, "v")
+	k2.Set(err, 1)
+
+	v1, ok := k1.Get(err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(v1).To(Equal("v"))
+
+	v2, ok := k2.Get(errorz.Wrap(errors.Join(err), fmt.Errorf("o")))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(v2).To(Equal(1))
+
+	g.Expect(errorz.GetSummary(err, false).Details).To(BeEmpty())
+	g.Expect(errorz.GetSummary(err, false).Metadata).To(BeNil())
+}
+
+func TestMetadataKey_Export(t *testing.T) {
+	g := NewWithT(t)
+
+	k1 := errorz.NewMetadataKey[string]("k1", errorz.MetadataExportDetails)
+	k2 := errorz.NewMetadataKey[int]("k2", errorz.MetadataExportMetadata)
+	k3 := errorz.NewMetadataKey[string]("token", errorz.MetadataExportMetadata)
+
+	inner := errorz.Errorf("inner")
+	k1.Set(inner, "v1")
+	k2.Set(inner, 1)
+
+	err := errorz.Wrap(errors.Join(inner), fmt.Errorf("outer"))
+	k2.Set(err, 2)
+	k3.Set(err, "t")
+
+	s := errorz.GetSummary(err, true)
+	g.Expect(s.Details).To(Equal(map[string]any{"k1": "v1"}))
+	g.Expect(s.Metadata).To(Equal(map[string]any{"k2": 2, "token": "[redacted]"}))
+	g.Expect(s.Components[0].Metadata).To(Equal(map[string]any{"k2": 2, "token": "[redacted]"}))
+	g.Expect(s.Components[0].Components[0].Components[0].Details).To(Equal(map[string]any{"k1": "v1"}))
+	g.Expect(s.Components[0].Components[0].Components[0].Metadata).To(Equal(map[string]any{"k2": 1}))
+
+	s = errorz.GetSummaryWithOptions(err, false, &errorz.SummaryOptions{Audience: errorz.SummaryAudiencePublic})
+	g.Expect(s.Metadata).To(Equal(map[string]any{"k2": 2}))
+	g.Expect(errorz.SDump(err)).To(ContainSubstring(`"k1": (string) (len=2) "v1"`))
+}
@@ -0,0 +1,187 @@
+package errorz
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// PrintStyles describes the styles used by [Fprint]. It is a subset of "outz.Styles", so "outz.DefaultStyles" can be
+// used directly.
+type PrintStyles interface {
+	// Default returns a style.
+	Default() *color.Color
+	// Highlight returns a style.
+	Highlight() *color.Color
+	// Secondary returns a style.
+	Secondary() *color.Color
+	// Error returns a style.
+	Error() *color.Color
+}
+
+// PrintOptions describes options for [Fprint].
+type PrintOptions struct {
+	// Styles are the styles used for colored output, no colors are used if nil.
+	Styles PrintStyles
+	// NoColor disables colored output even if styles are set, e.g. for logs and golden tests.
+	NoColor bool
+	// MaxFrames is the maximum number of frames to print for each wrapped error, or a negative number for no limit.
+	MaxFrames int
+}
+
+var (
+	defaultPrintOptions = &PrintOptions{
+		Styles:    nil,
+		NoColor:   false,
+		MaxFrames: 5,
+	}
+)
+
+// DefaultPrintOptions is a default, shared instance of [*PrintOptions], used by [Fprint] if opts is nil.
+var (
+	DefaultPrintOptions = defaultPrintOptions
+)
+
+// RestoreDefaultPrintOptions restores the default value of [DefaultPrintOptions].
+func RestoreDefaultPrintOptions() {
+	DefaultPrintOptions = defaultPrintOptions
+}
+
+// Fprint writes a human-readable representation of the error to w, suitable for interactive console output. The error
+// message is followed by its component tree, like in [GetSummary], including names, HTTP statuses, details (redacted
+// as in [SummaryAudienceInternal]), exported metadata, frames, and wrap trail. Does nothing if err is nil. If opts is
+// nil, [DefaultPrintOptions] is used.
+func Fprint(w io.Writer, err error, opts *PrintOptions) error {
+	if err == nil {
+		return nil
+	}
+
+	if opts == nil {
+		opts = DefaultPrintOptions
+	}
+
+	p := &printer{
+		opts:  opts,
+		lines: make([]string, 0),
+	}
+
+	messageLines := strings.Split(err.Error(), "\n")
+
+	for _, messageLine := range messageLines[1:] {
+		p.addLine(0, p.style(PrintStyles.Error, messageLine))
+	}
+
+	p.printComponent(err, 0)
+
+	buf := &strings.Builder{}
+	_, _ = fmt.Fprintf(buf, "%v %v\n", p.style(PrintStyles.Error, "┌─"), p.style(PrintStyles.Error, messageLines[0]))
+
+	for i, line := range p.lines {
+		if i == len(p.lines)-1 {
+			_, _ = fmt.Fprintf(buf, "%v%v\n", p.style(PrintStyles.Error, "└─   "), line)
+		} else {
+			_, _ = fmt.Fprintf(buf, "%v%v\n", p.style(PrintStyles.Error, "│    "), line)
+		}
+	}
+
+	_, wErr := io.WriteString(w, buf.String())
+	return MaybeWrap(wErr)
+}
+
+type printer struct {
+	opts  *PrintOptions
+	lines []string
+}
+
+func (p *printer) style(getColor func(PrintStyles) *color.Color, s string) string {
+	if p.opts.Styles == nil || p.opts.NoColor {
+		return s
+	}
+
+	return getColor(p.opts.Styles).Sprint(s)
+}
+
+func (p *printer) addLine(depth int, parts ...string) {
+	p.lines = append(p.lines, strings.Repeat("  ", depth)+strings.Join(parts, " "))
+}
+
+func (p *printer) printComponent(err error, depth int) {
+	cS := getComponentSummary(err)
+	attrs := make([]string, 0)
+
+	switch {
+	case cS.Name == "[wrap]" || cS.Name == "[join]":
+		p.addLine(depth, p.style(PrintStyles.Secondary, cS.Name))
+	case cS.Name != "":
+		messageLines := strings.Split(cS.Message, "\n")
+		p.addLine(depth, p.style(PrintStyles.Highlight, cS.Name+":"), p.style(PrintStyles.Default, messageLines[0]))
+
+		for _, messageLine := range messageLines[1:] {
+			p.addLine(depth+1, p.style(PrintStyles.Default, messageLine))
+		}
+	default:
+		for _, messageLine := range strings.Split(cS.Message, "\n") {
+			p.addLine(depth, p.style(PrintStyles.Default, messageLine))
+		}
+	}
+
+	if cS.HTTPStatus != 0 {
+		attrs = append(attrs, p.formatAttr("httpStatus", cS.HTTPStatus))
+	}
+
+	for _, m := range []map[string]any{cS.Details, cS.Metadata} {
+		m = redactMap(m, SummaryAudienceInternal)
+
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			attrs = append(attrs, p.formatAttr(k, m[k]))
+		}
+	}
+
+	if len(attrs) > 0 {
+		p.addLine(depth+1, attrs...)
+	}
+
+	if e, ok := err.(*wrappedError); ok { //nolint:errorlint
+		frames := GetFrames(e)
+
+		if p.opts.MaxFrames >= 0 && len(frames) > p.opts.MaxFrames {
+			frames = frames[:p.opts.MaxFrames]
+		}
+
+		for _, frame := range frames {
+			p.addLine(depth+1, p.style(PrintStyles.Secondary, "at "+formatShortFrame(frame)))
+		}
+
+		for _, frame := range e.getWrapTrail() {
+			p.addLine(depth+1, p.style(PrintStyles.Secondary, "via "+formatShortFrame(frame)))
+		}
+	}
+
+	for _, uErr := range Unwrap(err) {
+		if uErr != nil {
+			p.printComponent(uErr, depth+1)
+		}
+	}
+}
+
+func (p *printer) formatAttr(k string, v any) string {
+	return fmt.Sprintf("%v=%v", p.style(PrintStyles.Highlight, k), v)
+}
+
+func formatShortFrame(frame *Frame) string {
+	if frame.File == "" {
+		return frame.ShortLocation
+	}
+
+	return fmt.Sprintf("%v (%v:%v)", frame.ShortLocation, filepath.Base(frame.File), frame.Line)
+}
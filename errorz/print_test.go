@@ -0,0 +1,109 @@
+package errorz_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+type printTestStyles struct {
+	// intentionally empty
+}
+
+func (*printTestStyles) Default() *color.Color {
+	c := color.New(color.Reset)
+	c.EnableColor()
+	return c
+}
+
+func (*printTestStyles) Highlight() *color.Color {
+	c := color.New(color.Bold)
+	c.EnableColor()
+	return c
+}
+
+func (*printTestStyles) Secondary() *color.Color {
+	c := color.New(color.Faint)
+	c.EnableColor()
+	return c
+}
+
+func (*printTestStyles) Error() *color.Color {
+	c := color.New(color.FgHiRed)
+	c.EnableColor()
+	return c
+}
+
+type printTestFailingWriter struct {
+	// intentionally empty
+}
+
+func (*printTestFailingWriter) Write(_ []byte) (int, error) {
+	return 0, fmt.Errorf("write error")
+}
+
+func TestFprint(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(
+		errors.Join(fmt.Errorf("e1"), fmt.Errorf("e2")),
+		&terrorz.SimpleMockTestDetailedError{
+			ErrorMessage: "o1",
+			Name:         "mock",
+			HTTPStatus:   500,
+			Details:      map[string]any{"k2": "v2", "k1": "v1", "token": "t"},
+		})
+
+	buf := &bytes.Buffer{}
+	g.Expect(errorz.Fprint(buf, err, &errorz.PrintOptions{MaxFrames: 1})).To(Succeed())
+	g.Expect(regexp.MustCompile(`\(print_test\.go:\d+\)`).ReplaceAllString(buf.String(), "(print_test.go:N)")).
+		To(Equal("" +
+			"┌─ o1: e1\n" +
+			"│    e2\n" +
+			"│    [wrap]\n" +
+			"│      at errorz_test.TestFprint (print_test.go:N)\n" +
+			"│      [join]\n" +
+			"│        e1\n" +
+			"│        e2\n" +
+			"│      mock: o1\n" +
+			"└─       httpStatus=500 k1=v1 k2=v2 token=[redacted]\n"))
+
+	buf.Reset()
+	g.Expect(errorz.Fprint(buf, nil, nil)).To(Succeed())
+	g.Expect(buf.String()).To(BeEmpty())
+
+	g.Expect(errorz.Fprint(&printTestFailingWriter{}, err, nil)).ToNot(Succeed())
+}
+
+func TestFprint_Styles(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(fmt.Errorf("e"), errorz.Annotate(errorz.NotFoundf("o")))
+	err = errorz.Annotate(err)
+
+	buf := &bytes.Buffer{}
+	g.Expect(errorz.Fprint(buf, err, &errorz.PrintOptions{Styles: &printTestStyles{}, MaxFrames: -1})).
+		To(Succeed())
+	g.Expect(buf.String()).To(HavePrefix("\x1b[91m┌─\x1b[0m \x1b[91mo: e\x1b[0m\n"))
+	g.Expect(buf.String()).To(ContainSubstring("\x1b[1mnot-found:\x1b[22m \x1b[0mo\x1b[0m"))
+	g.Expect(buf.String()).To(MatchRegexp(`\x1b\[2mvia errorz_test\.TestFprint_Styles \(print_test\.go:\d+\)\x1b\[22m`))
+
+	buf.Reset()
+	g.Expect(errorz.Fprint(buf, err, &errorz.PrintOptions{Styles: &printTestStyles{}, NoColor: true})).To(Succeed())
+	g.Expect(buf.String()).ToNot(ContainSubstring("\x1b"))
+
+	errorz.DefaultPrintOptions = &errorz.PrintOptions{MaxFrames: 0}
+	defer errorz.RestoreDefaultPrintOptions()
+
+	buf.Reset()
+	g.Expect(errorz.Fprint(buf, err, nil)).To(Succeed())
+	g.Expect(buf.String()).ToNot(ContainSubstring(" at "))
+}
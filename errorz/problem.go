@@ -0,0 +1,253 @@
+package errorz
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+)
+
+// ProblemContentType is the content type of RFC 7807 problem documents.
+const (
+	ProblemContentType = "application/problem+json"
+)
+
+const (
+	problemTypeBlank = "about:blank"
+)
+
+var (
+	defaultProblemSummaryOptions = &SummaryOptions{
+		IncludeFingerprint: false,
+		Audience:           SummaryAudiencePublic,
+		ServerErrorMessage: "internal error",
+		MessageCatalog:     nil,
+		Language:           "",
+	}
+)
+
+// DefaultProblemSummaryOptions is a default, shared instance of [*SummaryOptions], used by [MarshalProblem]. Since
+// problem documents are usually sent to external clients, it uses [SummaryAudiencePublic] and replaces the messages of
+// server errors (5xx) with "internal error".
+var (
+	DefaultProblemSummaryOptions = defaultProblemSummaryOptions
+)
+
+// RestoreDefaultProblemSummaryOptions restores the default value of [DefaultProblemSummaryOptions].
+func RestoreDefaultProblemSummaryOptions() {
+	DefaultProblemSummaryOptions = defaultProblemSummaryOptions
+}
+
+var (
+	problemReservedMembers = map[string]struct{}{
+		"type":     {},
+		"title":    {},
+		"status":   {},
+		"detail":   {},
+		"instance": {},
+	}
+)
+
+var (
+	_ json.Marshaler   = (*Problem)(nil)
+	_ json.Unmarshaler = (*Problem)(nil)
+)
+
+// Problem describes an RFC 7807 "application/problem+json" document.
+type Problem struct {
+	Type       string
+	Title      string
+	Status     int
+	Detail     string
+	Instance   string
+	Extensions map[string]any
+}
+
+// NewProblem converts a [*Summary] to a [*Problem]. The summary name becomes type and title, the HTTP status becomes
+// status, the message becomes detail, and the details become extension members. Returns nil if s is nil.
+func NewProblem(s *Summary) *Problem {
+	if s == nil {
+		return nil
+	}
+
+	p := &Problem{
+		Type:       problemTypeBlank,
+		Title:      s.Name,
+		Status:     s.HTTPStatus,
+		Detail:     s.Message,
+		Instance:   "",
+		Extensions: make(map[string]any),
+	}
+
+	if s.Name != "" {
+		p.Type = s.Name
+	} else if s.HTTPStatus != 0 {
+		p.Title = http.StatusText(s.HTTPStatus)
+	}
+
+	for k, v := range s.Details {
+		if _, ok := problemReservedMembers[k]; !ok {
+			p.Extensions[k] = v
+		}
+	}
+
+	return p
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(p.Extensions)+5)
+
+	for k, v := range p.Extensions {
+		if _, ok := problemReservedMembers[k]; !ok {
+			m[k] = v
+		}
+	}
+
+	setIfNotZero(m, "type", p.Type)
+	setIfNotZero(m, "title", p.Title)
+	setIfNotZero(m, "status", p.Status)
+	setIfNotZero(m, "detail", p.Detail)
+	setIfNotZero(m, "instance", p.Instance)
+
+	buf, err := json.Marshal(m)
+	return buf, MaybeWrap(err)
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (p *Problem) UnmarshalJSON(buf []byte) error {
+	type problem struct {
+		Type     string `json:"type"`
+		Title    string `json:"title"`
+		Status   int    `json:"status"`
+		Detail   string `json:"detail"`
+		Instance string `json:"instance"`
+	}
+
+	rp := &problem{}
+	if err := json.Unmarshal(buf, rp); err != nil {
+		return Wrap(err)
+	}
+
+	m := make(map[string]any)
+	if err := json.Unmarshal(buf, &m); err != nil {
+		return Wrap(err)
+	}
+
+	*p = Problem{
+		Type:       rp.Type,
+		Title:      rp.Title,
+		Status:     rp.Status,
+		Detail:     rp.Detail,
+		Instance:   rp.Instance,
+		Extensions: make(map[string]any),
+	}
+
+	for k, v := range m {
+		if _, ok := problemReservedMembers[k]; !ok {
+			p.Extensions[k] = v
+		}
+	}
+
+	return nil
+}
+
+// ToError converts the [*Problem] to a [*ProblemError].
+func (p *Problem) ToError() *ProblemError {
+	name := p.Type
+	if name == problemTypeBlank {
+		name = ""
+	}
+
+	return &ProblemError{
+		name:       name,
+		title:      p.Title,
+		message:    p.Detail,
+		httpStatus: p.Status,
+		details:    maps.Clone(p.Extensions),
+	}
+}
+
+// MarshalProblem renders the error as an RFC 7807 problem document, using [DefaultProblemSummaryOptions].
+func MarshalProblem(err error) ([]byte, error) {
+	return MarshalProblemWithOptions(err, DefaultProblemSummaryOptions)
+}
+
+// MarshalProblemWithOptions is like [MarshalProblem], but uses the given options to obtain the summary. If opts is nil,
+// [DefaultProblemSummaryOptions] is used.
+func MarshalProblemWithOptions(err error, opts *SummaryOptions) ([]byte, error) {
+	if opts == nil {
+		opts = DefaultProblemSummaryOptions
+	}
+
+	buf, mErr := json.Marshal(NewProblem(GetSummaryWithOptions(err, false, opts)))
+	return buf, MaybeWrap(mErr)
+}
+
+// UnmarshalProblem parses an RFC 7807 problem document and converts it to an error.
+func UnmarshalProblem(buf []byte) (*ProblemError, error) {
+	p := &Problem{}
+	if err := json.Unmarshal(buf, p); err != nil {
+		return nil, Wrap(err)
+	}
+
+	return p.ToError(), nil
+}
+
+var (
+	_ error           = (*ProblemError)(nil)
+	_ ErrorName       = (*ProblemError)(nil)
+	_ ErrorHTTPStatus = (*ProblemError)(nil)
+	_ ErrorDetails    = (*ProblemError)(nil)
+)
+
+// ProblemError describes an error parsed from an RFC 7807 problem document.
+type ProblemError struct {
+	name       string
+	title      string
+	message    string
+	httpStatus int
+	details    map[string]any
+}
+
+// Error implements the error interface.
+func (e *ProblemError) Error() string {
+	switch {
+	case e.message != "":
+		return e.message
+	case e.title != "":
+		return e.title
+	case e.name != "":
+		return e.name
+	case e.httpStatus != 0:
+		return http.StatusText(e.httpStatus)
+	default:
+		return "unknown problem"
+	}
+}
+
+// GetErrorName implements the [ErrorName] interface.
+func (e *ProblemError) GetErrorName() string {
+	return e.name
+}
+
+// GetErrorHTTPStatus implements the [ErrorHTTPStatus] interface.
+func (e *ProblemError) GetErrorHTTPStatus() int {
+	return e.httpStatus
+}
+
+// GetErrorDetails implements the [ErrorDetails] interface.
+func (e *ProblemError) GetErrorDetails() map[string]any {
+	if len(e.details) > 0 {
+		return e.details
+	}
+
+	return nil
+}
+
+func setIfNotZero[T comparable](m map[string]any, k string, v T) {
+	var zero T
+
+	if v != zero {
+		m[k] = v
+	}
+}
@@ -0,0 +1,167 @@
+package errorz_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+func TestNewProblem(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.NewProblem(nil)).To(BeNil())
+
+	g.Expect(errorz.NewProblem(&errorz.Summary{
+		Name:       "e1",
+		Message:    "e1-err",
+		HTTPStatus: 400,
+		Details: map[string]any{
+			"k":     "v",
+			"title": "ignored",
+		},
+	})).To(Equal(&errorz.Problem{
+		Type:   "e1",
+		Title:  "e1",
+		Status: 400,
+		Detail: "e1-err",
+		Extensions: map[string]any{
+			"k": "v",
+		},
+	}))
+
+	g.Expect(errorz.NewProblem(&errorz.Summary{
+		Message:    "e2-err",
+		HTTPStatus: 500,
+	})).To(Equal(&errorz.Problem{
+		Type:       "about:blank",
+		Title:      "Internal Server Error",
+		Status:     500,
+		Detail:     "e2-err",
+		Extensions: map[string]any{},
+	}))
+}
+
+func TestProblem_JSON(t *testing.T) {
+	g := NewWithT(t)
+
+	buf, err := json.Marshal(&errorz.Problem{
+		Type:     "e1",
+		Title:    "e1",
+		Status:   400,
+		Detail:   "e1-err",
+		Instance: "",
+		Extensions: map[string]any{
+			"k":      "v",
+			"status": 500,
+		},
+	})
+	g.Expect(err).To(Succeed())
+	g.Expect(buf).To(MatchJSON(`{"type":"e1","title":"e1","status":400,"detail":"e1-err","k":"v"}`))
+
+	p := &errorz.Problem{}
+	g.Expect(json.Unmarshal(buf, p)).To(Succeed())
+	g.Expect(p).To(Equal(&errorz.Problem{
+		Type:   "e1",
+		Title:  "e1",
+		Status: 400,
+		Detail: "e1-err",
+		Extensions: map[string]any{
+			"k": "v",
+		},
+	}))
+
+	g.Expect(json.Unmarshal([]byte(`{"status":"x"}`), p)).ToNot(Succeed())
+	g.Expect(json.Unmarshal([]byte(`[]`), p)).ToNot(Succeed())
+}
+
+func TestMarshalUnmarshalProblem(t *testing.T) {
+	g := NewWithT(t)
+
+	buf, err := errorz.MarshalProblem(errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e1-err",
+		Name:         "e1",
+		HTTPStatus:   404,
+		Details:      map[string]any{"id": "x"},
+	}))
+	g.Expect(err).To(Succeed())
+	g.Expect(buf).To(MatchJSON(`{"type":"e1","title":"e1","status":404,"detail":"e1-err","id":"x"}`))
+
+	pErr, err := errorz.UnmarshalProblem(buf)
+	g.Expect(err).To(Succeed())
+	g.Expect(pErr).To(MatchError("e1-err"))
+	g.Expect(pErr.GetErrorName()).To(Equal("e1"))
+	g.Expect(pErr.GetErrorHTTPStatus()).To(Equal(404))
+	g.Expect(pErr.GetErrorDetails()).To(Equal(map[string]any{"id": "x"}))
+
+	g.Expect(errorz.GetSummary(pErr, false)).To(Equal(&errorz.Summary{
+		Name:       "e1",
+		Message:    "e1-err",
+		HTTPStatus: 404,
+		Details:    map[string]any{"id": "x"},
+	}))
+
+	_, err = errorz.UnmarshalProblem([]byte(`{`))
+	g.Expect(err).ToNot(Succeed())
+}
+
+func TestMarshalProblemWithOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "db: connection refused",
+		Name:         "db-error",
+		HTTPStatus:   500,
+		Details:      map[string]any{"password": "p", "host": "h"},
+	})
+
+	buf, mErr := errorz.MarshalProblem(err)
+	g.Expect(mErr).To(Succeed())
+	g.Expect(buf).To(MatchJSON(`{"type":"db-error","title":"db-error","status":500,"detail":"internal error","host":"h"}`))
+
+	buf, mErr = errorz.MarshalProblemWithOptions(err, nil)
+	g.Expect(mErr).To(Succeed())
+	g.Expect(buf).To(MatchJSON(`{"type":"db-error","title":"db-error","status":500,"detail":"internal error","host":"h"}`))
+
+	buf, mErr = errorz.MarshalProblemWithOptions(err, &errorz.SummaryOptions{})
+	g.Expect(mErr).To(Succeed())
+	g.Expect(buf).To(MatchJSON(`{"type":"db-error","title":"db-error","status":500,` +
+		`"detail":"db: connection refused","password":"[redacted]","host":"h"}`))
+}
+
+func TestDefaultProblemSummaryOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e1-err",
+		Name:         "e1",
+		HTTPStatus:   500,
+	})
+
+	defer errorz.RestoreDefaultProblemSummaryOptions()
+	errorz.DefaultProblemSummaryOptions = &errorz.SummaryOptions{}
+	g.Expect(errorz.MarshalProblem(err)).To(MatchJSON(`{"type":"e1","title":"e1","status":500,"detail":"e1-err"}`))
+
+	errorz.RestoreDefaultProblemSummaryOptions()
+	g.Expect(errorz.MarshalProblem(err)).To(MatchJSON(`{"type":"e1","title":"e1","status":500,"detail":"internal error"}`))
+}
+
+func TestProblemError(t *testing.T) {
+	g := NewWithT(t)
+
+	err := (&errorz.Problem{Type: "about:blank", Title: "Not Found", Status: 404}).ToError()
+	g.Expect(err).To(MatchError("Not Found"))
+	g.Expect(err.GetErrorName()).To(Equal(""))
+	g.Expect(err.GetErrorHTTPStatus()).To(Equal(404))
+	g.Expect(err.GetErrorDetails()).To(BeNil())
+
+	err = (&errorz.Problem{Status: 404}).ToError()
+	g.Expect(err).To(MatchError("Not Found"))
+	g.Expect(err.GetErrorName()).To(Equal(""))
+
+	err = (&errorz.Problem{}).ToError()
+	g.Expect(err).To(MatchError("unknown problem"))
+}
@@ -0,0 +1,178 @@
+package errorz
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+)
+
+const (
+	redactedValue = "[redacted]"
+)
+
+var (
+	_ fmt.Stringer   = Secret{}
+	_ fmt.GoStringer = Secret{}
+	_ json.Marshaler = Secret{}
+	_ slog.LogValuer = Secret{}
+)
+
+// Secret wraps a sensitive value (e.g. a token or an email address) so that it can be safely attached to errors as a
+// detail or metadata value. Secrets are redacted when summarized, formatted, marshaled, logged, or dumped: the value is
+// stored in a closure so that even reflection-based dumps cannot reach it.
+type Secret struct {
+	value func() any
+}
+
+// NewSecret initializes a new [Secret].
+func NewSecret(v any) Secret {
+	return Secret{
+		value: func() any { return v },
+	}
+}
+
+// Reveal returns the wrapped value.
+func (s Secret) Reveal() any {
+	if s.value == nil {
+		return nil
+	}
+
+	return s.value()
+}
+
+// String implements the [fmt.Stringer] interface.
+func (Secret) String() string {
+	return redactedValue
+}
+
+// GoString implements the [fmt.GoStringer] interface.
+func (Secret) GoString() string {
+	return redactedValue
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (Secret) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + redactedValue + `"`), nil
+}
+
+// LogValue implements the [slog.LogValuer] interface.
+func (Secret) LogValue() slog.Value {
+	return slog.StringValue(redactedValue)
+}
+
+var (
+	defaultSensitiveKeyPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)passw(or)?d`),
+		regexp.MustCompile(`(?i)secret`),
+		regexp.MustCompile(`(?i)token`),
+		regexp.MustCompile(`(?i)api[-_]?key`),
+		regexp.MustCompile(`(?i)authorization`),
+		regexp.MustCompile(`(?i)cookie`),
+		regexp.MustCompile(`(?i)credential`),
+		regexp.MustCompile(`(?i)e-?mail`),
+	}
+)
+
+// DefaultSensitiveKeyPatterns is a default, shared list of patterns used to detect sensitive details and metadata by
+// key. Values with a matching key are redacted like [Secret] values. Keys of nested maps are not matched (e.g. they
+// may be field paths in validation results), but nested [Secret] values are still redacted. Note that the
+// default patterns change the output of [GetSummary] (and everything based on it) for keys such as "token" or "email",
+// which were previously included verbatim: set it to nil to disable key-based redaction.
+var (
+	DefaultSensitiveKeyPatterns = defaultSensitiveKeyPatterns
+)
+
+// RestoreDefaultSensitiveKeyPatterns restores the default value of [DefaultSensitiveKeyPatterns].
+func RestoreDefaultSensitiveKeyPatterns() {
+	DefaultSensitiveKeyPatterns = defaultSensitiveKeyPatterns
+}
+
+// SummaryAudience describes the audience of a [*Summary], which determines how sensitive values are redacted.
+type SummaryAudience int
+
+// Known summary audiences.
+const (
+	// SummaryAudienceInternal replaces sensitive values with a placeholder.
+	SummaryAudienceInternal SummaryAudience = 0
+	// SummaryAudiencePublic removes sensitive values, as well as wrap trails (which contain source paths).
+	SummaryAudiencePublic SummaryAudience = 1
+)
+
+// IsSensitive returns true if the given detail or metadata (k, v) is sensitive, i.e. if v is a [Secret] or k matches
+// any of the [DefaultSensitiveKeyPatterns].
+func IsSensitive(k string, v any) bool {
+	if _, ok := v.(Secret); ok {
+		return true
+	}
+
+	for _, p := range DefaultSensitiveKeyPatterns {
+		if p.MatchString(k) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func redactMap(m map[string]any, audience SummaryAudience) map[string]any {
+	if m == nil {
+		return nil
+	}
+
+	rm := make(map[string]any, len(m))
+
+	for k, v := range m {
+		switch {
+		case !IsSensitive(k, v):
+			rm[k] = redactNestedValue(v, audience)
+		case audience == SummaryAudienceInternal:
+			rm[k] = redactedValue
+		}
+	}
+
+	return rm
+}
+
+func redactNestedValue(v any, audience SummaryAudience) any {
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v
+	}
+
+	rm := make(map[string]any, len(m))
+
+	for k, v := range m {
+		_, isSecret := v.(Secret)
+
+		switch {
+		case !isSecret:
+			rm[k] = redactNestedValue(v, audience)
+		case audience == SummaryAudienceInternal:
+			rm[k] = redactedValue
+		}
+	}
+
+	return rm
+}
+
+func redactSummary(s *Summary, opts *SummaryOptions) {
+	walkSummary(s, func(iS *Summary) {
+		iS.Details = redactMap(iS.Details, opts.Audience)
+		iS.Metadata = redactMap(iS.Metadata, opts.Audience)
+
+		if opts.Audience == SummaryAudiencePublic {
+			iS.WrapTrail = nil
+		}
+	})
+
+	if opts.ServerErrorMessage != "" && s.HTTPStatus >= 500 {
+		walkSummary(s, func(iS *Summary) {
+			if iS != s && iS.Message != "" {
+				iS.Message = opts.ServerErrorMessage
+			}
+		})
+
+		s.Message = opts.ServerErrorMessage
+	}
+}
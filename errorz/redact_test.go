@@ -0,0 +1,161 @@
+package errorz_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+func TestSecret(t *testing.T) {
+	g := NewWithT(t)
+
+	s := errorz.NewSecret("s3cr3t")
+	g.Expect(s.Reveal()).To(Equal("s3cr3t"))
+	g.Expect(errorz.Secret{}.Reveal()).To(BeNil())
+	g.Expect(fmt.Sprintf("%v|%s|%+v|%#v", s, s, s, s)).To(Equal("[redacted]|[redacted]|[redacted]|[redacted]"))
+	g.Expect(json.Marshal(map[string]any{"k": s})).To(Equal([]byte(`{"k":"[redacted]"}`)))
+	g.Expect(slog.AnyValue(s).Resolve().String()).To(Equal("[redacted]"))
+	g.Expect(errorz.SDump(errorz.WrapRecover(s))).ToNot(ContainSubstring("s3cr3t"))
+}
+
+func TestIsSensitive(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.IsSensitive("k", "v")).To(BeFalse())
+	g.Expect(errorz.IsSensitive("k", errorz.NewSecret("v"))).To(BeTrue())
+
+	for _, k := range []string{"password", "userPasswd", "client_secret", "accessToken", "API-Key", "apikey",
+		"Authorization", "Set-Cookie", "credentials", "email", "e-mail"} {
+		g.Expect(errorz.IsSensitive(k, "v")).To(BeTrue(), k)
+	}
+
+	errorz.DefaultSensitiveKeyPatterns = []*regexp.Regexp{regexp.MustCompile("^k$")}
+	defer errorz.RestoreDefaultSensitiveKeyPatterns()
+
+	g.Expect(errorz.IsSensitive("k", "v")).To(BeTrue())
+	g.Expect(errorz.IsSensitive("password", "v")).To(BeFalse())
+}
+
+func TestGetSummaryWithOptions_Redaction(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e",
+		Name:         "n",
+		HTTPStatus:   503,
+		Details: map[string]any{
+			"k":     "v",
+			"token": "t",
+			"s":     errorz.NewSecret("v"),
+		},
+	})
+
+	s := errorz.GetSummary(err, true)
+	g.Expect(s.Message).To(Equal("e"))
+	g.Expect(s.Details).To(Equal(map[string]any{"k": "v", "token": "[redacted]", "s": "[redacted]"}))
+	g.Expect(s.Components[0].Components[0].Details).To(Equal(s.Details))
+
+	s = errorz.GetSummaryWithOptions(err, true, &errorz.SummaryOptions{Audience: errorz.SummaryAudiencePublic})
+	g.Expect(s.Details).To(Equal(map[string]any{"k": "v"}))
+	g.Expect(s.Components[0].Components[0].Details).To(Equal(s.Details))
+
+	s = errorz.GetSummaryWithOptions(err, true, &errorz.SummaryOptions{ServerErrorMessage: "internal error"})
+	g.Expect(s.Message).To(Equal("internal error"))
+	g.Expect(s.Components[0].Message).To(BeEmpty())
+	g.Expect(s.Components[0].Components[0].Message).To(Equal("internal error"))
+
+	s = errorz.GetSummaryWithOptions(errorz.NotFoundf("e"), false, &errorz.SummaryOptions{ServerErrorMessage: "x"})
+	g.Expect(s.Message).To(Equal("e"))
+}
+
+func TestRedaction_Output(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e",
+		Details:      map[string]any{"email": "a@b.c", "s": errorz.NewSecret("s3cr3t")},
+	})
+	errorz.MaybeSetMetadata(err, "apiKey", "k3y")
+
+	g.Expect(fmt.Sprintf("%+v", err)).ToNot(Or(ContainSubstring("a@b.c"), ContainSubstring("s3cr3t")))
+	g.Expect(errorz.SDump(err)).ToNot(Or(ContainSubstring("a@b.c"), ContainSubstring("s3cr3t"), ContainSubstring("k3y")))
+	g.Expect(errorz.SDump(err)).To(ContainSubstring(`Raw: (string) (len=10) "[redacted]"`))
+
+	buf := &bytes.Buffer{}
+	slog.New(slog.NewJSONHandler(buf, nil)).Error("m", "err", err)
+	g.Expect(buf.String()).ToNot(Or(ContainSubstring("a@b.c"), ContainSubstring("s3cr3t"), ContainSubstring("k3y")))
+	g.Expect(buf.String()).To(ContainSubstring(`"apiKey":"[redacted]"`))
+}
+
+func TestRedaction_SDump(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e",
+		Details:      map[string]any{"s": errorz.NewSecret("s3cr3t"), "n": nil, "password": nil},
+		HTTPStatus:   400,
+	})
+
+	out := errorz.SDump(err)
+	g.Expect(out).ToNot(ContainSubstring("s3cr3t"))
+	g.Expect(out).To(ContainSubstring("Raw: (*errorz.wrappedError)"))
+
+	errorz.MaybeSetMetadata(err, "token", 4)
+
+	out = errorz.SDump(err)
+	g.Expect(out).To(ContainSubstring(`Raw: (string) (len=10) "[redacted]"`))
+	g.Expect(out).To(ContainSubstring("HTTPStatus: (int) 400"))
+	g.Expect(out).ToNot(MatchRegexp(`\[redacted\]\w`))
+}
+
+func TestRedaction_Nested(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e",
+		Details: map[string]any{
+			"fields": map[string]any{
+				"user.email": "invalid",
+				"auth":       map[string]any{"token": errorz.NewSecret("t0k3n"), "kind": "bearer"},
+			},
+		},
+	})
+
+	g.Expect(errorz.GetSummary(err, false).Details).To(Equal(map[string]any{
+		"fields": map[string]any{
+			"user.email": "invalid",
+			"auth":       map[string]any{"token": "[redacted]", "kind": "bearer"},
+		},
+	}))
+
+	g.Expect(errorz.GetSummaryWithOptions(err, false, &errorz.SummaryOptions{
+		Audience: errorz.SummaryAudiencePublic,
+	}).Details).To(Equal(map[string]any{
+		"fields": map[string]any{
+			"user.email": "invalid",
+			"auth":       map[string]any{"kind": "bearer"},
+		},
+	}))
+
+	out := errorz.SDump(err)
+	g.Expect(out).ToNot(ContainSubstring("t0k3n"))
+	g.Expect(out).To(ContainSubstring("bearer"))
+
+	c := errorz.NewCollector(0)
+	c.Add("user.email", fmt.Errorf("invalid"))
+	c.Add("password", fmt.Errorf("required"))
+	errs := map[string]string{"user.email": "invalid", "password": "required"}
+
+	g.Expect(errorz.GetSummary(c.Err(), false).Details["errors"]).To(Equal(errs))
+	g.Expect(errorz.GetSummaryWithOptions(c.Err(), false, &errorz.SummaryOptions{
+		Audience: errorz.SummaryAudiencePublic,
+	}).Details["errors"]).To(Equal(errs))
+}
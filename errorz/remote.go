@@ -0,0 +1,165 @@
+package errorz
+
+import (
+	"errors"
+	"maps"
+	"sync"
+)
+
+// SummaryDecoder converts a [*Summary] with the given name back to a concrete error type. It receives the already
+// reconstructed inner errors (if any).
+type SummaryDecoder func(s *Summary, innerErrs []error) error
+
+var (
+	summaryDecodersM = &sync.RWMutex{}
+	summaryDecoders  = make(map[string]SummaryDecoder)
+)
+
+// RegisterSummaryDecoder registers a [SummaryDecoder] for errors with the given name, replacing any existing one.
+func RegisterSummaryDecoder(name string, decoder SummaryDecoder) {
+	summaryDecodersM.Lock()
+	defer summaryDecodersM.Unlock()
+
+	summaryDecoders[name] = decoder
+}
+
+// UnregisterSummaryDecoder unregisters the [SummaryDecoder] for errors with the given name, if any.
+func UnregisterSummaryDecoder(name string) {
+	summaryDecodersM.Lock()
+	defer summaryDecodersM.Unlock()
+
+	delete(summaryDecoders, name)
+}
+
+func getSummaryDecoder(name string) (SummaryDecoder, bool) {
+	summaryDecodersM.RLock()
+	defer summaryDecodersM.RUnlock()
+
+	decoder, ok := summaryDecoders[name]
+	return decoder, ok
+}
+
+// FromSummary reconstructs an error from a [*Summary], e.g. one received from a remote process. If the summary includes
+// components (i.e. it was obtained by calling [GetSummary] with includeComponents = true), the whole component tree is
+// reconstructed, otherwise a single [*RemoteError] is returned. Components are converted using the registered
+// [SummaryDecoder] for their name, or to [*RemoteError] if none is found. Returns nil if s is nil.
+func FromSummary(s *Summary) error {
+	if s == nil {
+		return nil
+	}
+
+	if len(s.Components) > 0 {
+		return fromSummaryInternal(s.Components[0])
+	}
+
+	return fromSummaryInternal(&Summary{
+		Name:        s.Name,
+		Message:     s.Message,
+		UserMessage: s.UserMessage,
+		HTTPStatus:  s.HTTPStatus,
+		Details:     s.Details,
+		Metadata:    nil,
+		Fingerprint: "",
+		WrapTrail:   nil,
+		Snippets:    nil,
+		Components:  nil,
+	})
+}
+
+func fromSummaryInternal(s *Summary) error {
+	innerErrs := make([]error, 0, len(s.Components))
+
+	for _, cS := range s.Components {
+		if cS != nil {
+			innerErrs = append(innerErrs, fromSummaryInternal(cS))
+		}
+	}
+
+	switch s.Name {
+	case "[wrap]":
+		if len(innerErrs) > 0 {
+			return Wrap(innerErrs[0], innerErrs[1:]...)
+		}
+	case "[join]":
+		if len(innerErrs) > 0 {
+			return errors.Join(innerErrs...)
+		}
+	default:
+		if decoder, ok := getSummaryDecoder(s.Name); ok {
+			if err := decoder(s, innerErrs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return &RemoteError{
+		name:       s.Name,
+		message:    s.Message,
+		httpStatus: s.HTTPStatus,
+		details:    maps.Clone(s.Details),
+		errs:       innerErrs,
+	}
+}
+
+var (
+	_ error           = (*RemoteError)(nil)
+	_ ErrorName       = (*RemoteError)(nil)
+	_ ErrorHTTPStatus = (*RemoteError)(nil)
+	_ ErrorDetails    = (*RemoteError)(nil)
+	_ UnwrapMulti     = (*RemoteError)(nil)
+)
+
+// RemoteError describes an error reconstructed from a [*Summary] for which no [SummaryDecoder] was registered.
+type RemoteError struct {
+	name       string
+	message    string
+	httpStatus int
+	details    map[string]any
+	errs       []error
+}
+
+// Error implements the error interface.
+func (e *RemoteError) Error() string {
+	return e.message
+}
+
+// GetErrorName implements the [ErrorName] interface.
+func (e *RemoteError) GetErrorName() string {
+	return e.name
+}
+
+// GetErrorHTTPStatus implements the [ErrorHTTPStatus] interface.
+func (e *RemoteError) GetErrorHTTPStatus() int {
+	return e.httpStatus
+}
+
+// GetErrorDetails implements the [ErrorDetails] interface.
+func (e *RemoteError) GetErrorDetails() map[string]any {
+	if len(e.details) > 0 {
+		return e.details
+	}
+
+	return nil
+}
+
+// Unwrap implements the [UnwrapMulti] interface.
+func (e *RemoteError) Unwrap() []error {
+	if e == nil || len(e.errs) == 0 {
+		return nil
+	}
+
+	return e.errs
+}
+
+// Is allows [errors.Is] to match a [*RemoteError] against any error with the same (non-empty) name.
+func (e *RemoteError) Is(target error) bool {
+	if e.name == "" {
+		return false
+	}
+
+	if t, ok := target.(ErrorName); ok { //nolint:errorlint
+		return t.GetErrorName() == e.name
+	}
+
+	return false
+}
@@ -0,0 +1,107 @@
+package errorz_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+func TestFromSummary(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.FromSummary(nil)).To(Succeed())
+
+	e1a := &terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e1a-err",
+		Name:         "e1a",
+		HTTPStatus:   101,
+		Details:      map[string]any{"e1a": true},
+	}
+
+	e1b := fmt.Errorf("e1b: %w", e1a)
+	e2 := errors.Join(fmt.Errorf("e2a"), terrorz.TestStringError("e2b"))
+	err := errorz.Wrap(e1b, e2)
+
+	buf, jErr := json.Marshal(errorz.GetSummary(err, true))
+	g.Expect(jErr).To(Succeed())
+
+	s := &errorz.Summary{}
+	g.Expect(json.Unmarshal(buf, s)).To(Succeed())
+
+	rErr := errorz.FromSummary(s)
+	g.Expect(rErr).To(MatchError(err.Error()))
+	g.Expect(errorz.GetSummary(rErr, true)).To(Equal(s))
+
+	rErr1a, ok := errorz.As[*errorz.RemoteError](rErr)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(rErr1a.GetErrorName()).To(Equal(""))
+	g.Expect(rErr1a.Unwrap()).To(HaveLen(1))
+
+	g.Expect(errors.Is(rErr, &terrorz.SimpleMockTestDetailedError{Name: "e1a"})).To(BeTrue())
+	g.Expect(errors.Is(rErr, &terrorz.SimpleMockTestDetailedError{Name: "e1x"})).To(BeFalse())
+	g.Expect(errors.Is(rErr, fmt.Errorf("e2a"))).To(BeFalse())
+
+	rErr = errorz.FromSummary(errorz.GetSummary(err, false))
+	g.Expect(rErr).To(MatchError(err.Error()))
+	g.Expect(errorz.GetSummary(rErr, false)).To(Equal(errorz.GetSummary(err, false)))
+	g.Expect(errorz.Unwrap(rErr)).To(BeEmpty())
+}
+
+func TestFromSummary_Decoder(t *testing.T) {
+	g := NewWithT(t)
+
+	errorz.RegisterSummaryDecoder("e1", func(s *errorz.Summary, innerErrs []error) error {
+		g.Expect(innerErrs).To(HaveLen(1))
+
+		return &terrorz.SimpleMockTestDetailedUnwrapSingleError{
+			SimpleMockTestDetailedError: &terrorz.SimpleMockTestDetailedError{
+				ErrorMessage: s.Message,
+				Name:         s.Name,
+				HTTPStatus:   s.HTTPStatus,
+				Details:      s.Details,
+			},
+			UnwrapSingle: innerErrs[0],
+		}
+	})
+	defer errorz.UnregisterSummaryDecoder("e1")
+
+	errorz.RegisterSummaryDecoder("e2", func(_ *errorz.Summary, _ []error) error {
+		return nil
+	})
+	defer errorz.UnregisterSummaryDecoder("e2")
+
+	rErr := errorz.FromSummary(&errorz.Summary{
+		Components: []*errorz.Summary{
+			{
+				Name:       "e1",
+				Message:    "e1-err",
+				HTTPStatus: 400,
+				Components: []*errorz.Summary{
+					{
+						Name:    "e2",
+						Message: "e2-err",
+					},
+				},
+			},
+		},
+	})
+
+	e1, ok := errorz.As[*terrorz.SimpleMockTestDetailedUnwrapSingleError](rErr)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(e1.GetErrorHTTPStatus()).To(Equal(400))
+
+	e2, ok := errorz.As[*errorz.RemoteError](rErr)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(e2).To(MatchError("e2-err"))
+	g.Expect(e2.GetErrorName()).To(Equal("e2"))
+	g.Expect(e2.GetErrorHTTPStatus()).To(Equal(0))
+	g.Expect(e2.GetErrorDetails()).To(BeNil())
+	g.Expect(e2.Unwrap()).To(BeNil())
+	g.Expect(errors.Is(e2, fmt.Errorf("e2-err"))).To(BeFalse())
+}
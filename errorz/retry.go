@@ -0,0 +1,278 @@
+package errorz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// ErrorRetryable can be implemented by errors to declare whether the operation that caused them can be retried.
+type ErrorRetryable interface {
+	// IsErrorRetryable returns true if the operation that caused the error can be retried.
+	IsErrorRetryable() bool
+}
+
+type retryMetadataKey int
+
+// RetryableMetadataKey is a metadata key that can be used with [MaybeSetMetadata] to mark a wrapped error as retryable
+// (or not) with a bool value. It is considered by [DefaultRetryClassifier].
+const (
+	RetryableMetadataKey retryMetadataKey = 0
+)
+
+// RetryClassifier decides whether an error is retryable.
+type RetryClassifier func(err error) bool
+
+// DefaultRetryClassifier is the default [RetryClassifier]. In order of precedence, it considers:
+//   - the bool value of [RetryableMetadataKey], if set;
+//   - the [ErrorRetryable] interface, if implemented by any error in the unwrap tree;
+//   - context cancellation and deadline errors, which are never retryable;
+//   - the HTTP status from [GetSummary], retrying on 408, 425, 429, 500, 502, 503, and 504.
+func DefaultRetryClassifier(err error) bool {
+	if v, ok := MaybeGetMetadata[bool](err, RetryableMetadataKey); ok {
+		return v
+	}
+
+	if e, ok := As[ErrorRetryable](err); ok {
+		return e.IsErrorRetryable()
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	switch GetSummary(err, false).HTTPStatus {
+	case
+		http.StatusRequestTimeout,
+		http.StatusTooEarly,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// RetryPolicy describes a retry policy with exponential backoff and jitter.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, or zero for no limit.
+	MaxAttempts int
+	// MaxElapsedTime is the maximum time spent retrying, or zero for no limit.
+	MaxElapsedTime time.Duration
+	// InitialBackoff is the backoff before the second attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum backoff between attempts, or zero for no limit.
+	MaxBackoff time.Duration
+	// Multiplier is the factor by which the backoff increases after each attempt.
+	Multiplier float64
+	// Jitter is the fraction (between 0 and 1) by which each backoff is randomly increased or decreased.
+	Jitter float64
+	// Classifier decides whether an error is retryable, defaults to [DefaultRetryClassifier] if nil.
+	Classifier RetryClassifier
+	// Now returns the current time, defaults to [time.Now] if nil.
+	Now func() time.Time
+	// Sleep sleeps for the given duration or until the context is done, defaults to a timer if nil.
+	Sleep func(ctx context.Context, d time.Duration) error
+	// Random returns a random number in [0, 1), defaults to [rand.Float64] if nil.
+	Random func() float64
+}
+
+// NewRetryPolicy initializes a new [*RetryPolicy] with default values.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    5,
+		MaxElapsedTime: time.Minute,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+		Classifier:     DefaultRetryClassifier,
+		Now:            time.Now,
+		Sleep:          sleepCtx,
+		Random:         rand.Float64,
+	}
+}
+
+// GetBackoff returns the backoff after the given attempt (starting from 1). Without a MaxBackoff, the result is capped
+// at the maximum [time.Duration].
+func (p *RetryPolicy) GetBackoff(attempt int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(math.Max(p.Multiplier, 1), float64(attempt-1))
+
+	if p.MaxBackoff > 0 {
+		d = math.Min(d, float64(p.MaxBackoff))
+	}
+
+	if p.Jitter > 0 {
+		random := p.Random
+		if random == nil {
+			random = rand.Float64
+		}
+
+		d *= 1 + math.Min(p.Jitter, 1)*(2*random()-1)
+	}
+
+	switch {
+	case math.IsNaN(d) || d <= 0:
+		return 0
+	case d >= math.MaxInt64:
+		return math.MaxInt64
+	default:
+		return time.Duration(d)
+	}
+}
+
+// Retry runs f using [Catch0Ctx] until it succeeds or the policy decides to stop. If the policy is nil, the one
+// returned by [NewRetryPolicy] is used. If all attempts fail, it returns a wrapped [*RetryError] which includes the
+// errors from all the attempts.
+func Retry(ctx context.Context, p *RetryPolicy, f func(ctx context.Context) error) error {
+	return retry(ctx, p, func(ctx context.Context) error {
+		return Catch0Ctx(ctx, f)
+	})
+}
+
+// Retry1 is like [Retry] but runs a "func(context.Context) (T, error)" closure using [Catch1Ctx].
+func Retry1[T any](ctx context.Context, p *RetryPolicy, f func(ctx context.Context) (T, error)) (T, error) {
+	var out T
+
+	err := retry(ctx, p, func(ctx context.Context) error {
+		v, err := Catch1Ctx(ctx, f)
+		if err == nil {
+			out = v
+		}
+		return err
+	})
+
+	return out, err
+}
+
+func retry(ctx context.Context, p *RetryPolicy, f func(ctx context.Context) error) error {
+	if p == nil {
+		p = NewRetryPolicy()
+	}
+
+	classifier := p.Classifier
+	if classifier == nil {
+		classifier = DefaultRetryClassifier
+	}
+
+	now := p.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	sleep := p.Sleep
+	if sleep == nil {
+		sleep = sleepCtx
+	}
+
+	start := now()
+	attempts := 0
+	errs := make([]error, 0)
+
+	for {
+		attempts++
+		err := f(ctx)
+		if err == nil {
+			return nil
+		}
+
+		errs = append(errs, err)
+
+		if (p.MaxAttempts > 0 && attempts >= p.MaxAttempts) || !classifier(err) {
+			break
+		}
+
+		d := p.GetBackoff(attempts)
+
+		if p.MaxElapsedTime > 0 && now().Add(d).Sub(start) > p.MaxElapsedTime {
+			break
+		}
+
+		if sErr := sleep(ctx, d); sErr != nil {
+			errs = append(errs, sErr)
+			break
+		}
+	}
+
+	return Wrap(&RetryError{
+		attempts: attempts,
+		errs:     errs,
+		elapsed:  now().Sub(start),
+	})
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+var (
+	_ error        = (*RetryError)(nil)
+	_ ErrorName    = (*RetryError)(nil)
+	_ ErrorDetails = (*RetryError)(nil)
+	_ UnwrapMulti  = (*RetryError)(nil)
+)
+
+// RetryError describes the failure of all attempts made by [Retry].
+type RetryError struct {
+	attempts int
+	errs     []error
+	elapsed  time.Duration
+}
+
+// Error implements the error interface.
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("retry failed after %v attempt(s): %v", e.attempts, e.errs[len(e.errs)-1].Error())
+}
+
+// GetErrorName implements the [ErrorName] interface.
+func (*RetryError) GetErrorName() string {
+	return "retry-error"
+}
+
+// GetErrorDetails implements the [ErrorDetails] interface.
+func (e *RetryError) GetErrorDetails() map[string]any {
+	return map[string]any{
+		"attempts": e.attempts,
+		"elapsed":  e.elapsed.String(),
+	}
+}
+
+// Unwrap implements the [UnwrapMulti] interface.
+func (e *RetryError) Unwrap() []error {
+	if e == nil {
+		return nil
+	}
+
+	return e.errs
+}
+
+// GetAttempts returns the number of attempts.
+func (e *RetryError) GetAttempts() int {
+	return e.attempts
+}
+
+// GetErrors returns the errors from all the attempts, in order, followed by the context error that interrupted the
+// retries (if any).
+func (e *RetryError) GetErrors() []error {
+	return e.errs
+}
+
+// GetElapsed returns the total time spent retrying.
+func (e *RetryError) GetElapsed() time.Duration {
+	return e.elapsed
+}
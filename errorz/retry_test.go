@@ -0,0 +1,247 @@
+package errorz_test
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+type retryableTestError bool
+
+func (retryableTestError) Error() string {
+	return "retryable-test-error"
+}
+
+func (e retryableTestError) IsErrorRetryable() bool {
+	return bool(e)
+}
+
+type fakeRetryClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func newFakeRetryClock() *fakeRetryClock {
+	return &fakeRetryClock{
+		now:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		sleeps: nil,
+	}
+}
+
+func (c *fakeRetryClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeRetryClock) Sleep(_ context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func (c *fakeRetryClock) newPolicy() *errorz.RetryPolicy {
+	p := errorz.NewRetryPolicy()
+	p.Now = c.Now
+	p.Sleep = c.Sleep
+	p.Random = func() float64 { return 0.5 }
+	return p
+}
+
+func TestDefaultRetryClassifier(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.DefaultRetryClassifier(fmt.Errorf("e"))).To(BeFalse())
+	g.Expect(errorz.DefaultRetryClassifier(retryableTestError(true))).To(BeTrue())
+	g.Expect(errorz.DefaultRetryClassifier(errorz.Wrap(retryableTestError(false)))).To(BeFalse())
+	g.Expect(errorz.DefaultRetryClassifier(context.Canceled)).To(BeFalse())
+	g.Expect(errorz.DefaultRetryClassifier(errorz.Wrap(context.DeadlineExceeded))).To(BeFalse())
+	g.Expect(errorz.DefaultRetryClassifier(errorz.Unavailablef("e"))).To(BeTrue())
+	g.Expect(errorz.DefaultRetryClassifier(errorz.NotFoundf("e"))).To(BeFalse())
+	g.Expect(errorz.DefaultRetryClassifier(&terrorz.SimpleMockTestDetailedError{HTTPStatus: 429})).To(BeTrue())
+
+	err := errorz.NotFoundf("e")
+	errorz.MaybeSetMetadata(err, errorz.RetryableMetadataKey, true)
+	g.Expect(errorz.DefaultRetryClassifier(err)).To(BeTrue())
+
+	err = errorz.Wrap(retryableTestError(true))
+	errorz.MaybeSetMetadata(err, errorz.RetryableMetadataKey, false)
+	g.Expect(errorz.DefaultRetryClassifier(err)).To(BeFalse())
+}
+
+func TestRetryPolicy_GetBackoff(t *testing.T) {
+	g := NewWithT(t)
+
+	p := &errorz.RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+	}
+
+	g.Expect(p.GetBackoff(1)).To(Equal(time.Second))
+	g.Expect(p.GetBackoff(2)).To(Equal(2 * time.Second))
+	g.Expect(p.GetBackoff(3)).To(Equal(4 * time.Second))
+	g.Expect(p.GetBackoff(4)).To(Equal(5 * time.Second))
+
+	p.Jitter = 0.5
+	p.Random = func() float64 { return 0 }
+	g.Expect(p.GetBackoff(1)).To(Equal(500 * time.Millisecond))
+	p.Random = func() float64 { return 0.75 }
+	g.Expect(p.GetBackoff(1)).To(Equal(1250 * time.Millisecond))
+
+	p.Random = nil
+	g.Expect(p.GetBackoff(1)).To(And(
+		BeNumerically(">=", 500*time.Millisecond),
+		BeNumerically("<=", 1500*time.Millisecond)))
+
+	p = &errorz.RetryPolicy{
+		InitialBackoff: time.Second,
+		Multiplier:     2,
+	}
+
+	g.Expect(p.GetBackoff(100)).To(Equal(time.Duration(math.MaxInt64)))
+	g.Expect(p.GetBackoff(10000)).To(Equal(time.Duration(math.MaxInt64)))
+
+	p.Jitter = 0.5
+	p.Random = func() float64 { return 1 }
+	g.Expect(p.GetBackoff(64)).To(Equal(time.Duration(math.MaxInt64)))
+
+	p.InitialBackoff = 0
+	g.Expect(p.GetBackoff(10000)).To(BeZero())
+}
+
+func TestRetry_Success(t *testing.T) {
+	g := NewWithT(t)
+	c := newFakeRetryClock()
+	calls := 0
+
+	err := errorz.Retry(context.Background(), c.newPolicy(), func(_ context.Context) error {
+		calls++
+		if calls < 3 {
+			return errorz.Unavailablef("e%v", calls)
+		}
+		return nil
+	})
+	g.Expect(err).To(Succeed())
+	g.Expect(calls).To(Equal(3))
+	g.Expect(c.sleeps).To(HaveExactElements(100*time.Millisecond, 200*time.Millisecond))
+}
+
+func TestRetry_MaxAttempts(t *testing.T) {
+	g := NewWithT(t)
+	c := newFakeRetryClock()
+	calls := 0
+
+	err := errorz.Retry(context.Background(), c.newPolicy(), func(_ context.Context) error {
+		calls++
+		if calls == 2 {
+			panic(errorz.Unavailablef("p%v", calls))
+		}
+		return errorz.Unavailablef("e%v", calls)
+	})
+	g.Expect(err).To(MatchError("retry failed after 5 attempt(s): e5"))
+	g.Expect(calls).To(Equal(5))
+	g.Expect(c.sleeps).To(HaveLen(4))
+
+	rErr, ok := errorz.As[*errorz.RetryError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(rErr.GetAttempts()).To(Equal(5))
+	g.Expect(rErr.GetErrors()).To(HaveLen(5))
+	g.Expect(rErr.GetErrors()[1]).To(MatchError("p2"))
+	g.Expect(rErr.GetElapsed()).To(Equal(1500 * time.Millisecond))
+
+	s := errorz.GetSummary(err, true)
+	g.Expect(s.Details).To(HaveKeyWithValue("attempts", 5))
+	g.Expect(s.Details).To(HaveKeyWithValue("elapsed", "1.5s"))
+	g.Expect(s.Components[0].Components[0].Name).To(Equal("retry-error"))
+	g.Expect(s.Components[0].Components[0].Components).To(HaveLen(5))
+}
+
+func TestRetry_NotRetryable(t *testing.T) {
+	g := NewWithT(t)
+	c := newFakeRetryClock()
+	calls := 0
+
+	err := errorz.Retry(context.Background(), c.newPolicy(), func(_ context.Context) error {
+		calls++
+		return errorz.NotFoundf("e")
+	})
+	g.Expect(err).To(MatchError("retry failed after 1 attempt(s): e"))
+	g.Expect(errorz.KindOf(err)).To(Equal(errorz.KindNotFound))
+	g.Expect(calls).To(Equal(1))
+	g.Expect(c.sleeps).To(BeEmpty())
+}
+
+func TestRetry_MaxElapsedTime(t *testing.T) {
+	g := NewWithT(t)
+	c := newFakeRetryClock()
+	p := c.newPolicy()
+	p.MaxAttempts = 0
+	p.MaxElapsedTime = time.Second
+	p.Classifier = func(_ error) bool { return true }
+
+	err := errorz.Retry(context.Background(), p, func(_ context.Context) error {
+		return fmt.Errorf("e")
+	})
+	g.Expect(err).To(MatchError("retry failed after 4 attempt(s): e"))
+	g.Expect(c.sleeps).To(HaveExactElements(100*time.Millisecond, 200*time.Millisecond, 400*time.Millisecond))
+}
+
+func TestRetry_Canceled(t *testing.T) {
+	g := NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+
+	p := &errorz.RetryPolicy{
+		InitialBackoff: time.Hour,
+		Classifier:     func(_ error) bool { return true },
+	}
+
+	err := errorz.Retry(ctx, p, func(_ context.Context) error {
+		calls++
+		cancel()
+		return fmt.Errorf("e")
+	})
+	g.Expect(err).To(MatchError("retry failed after 1 attempt(s): context canceled"))
+	g.Expect(err).To(MatchError(context.Canceled))
+	g.Expect(calls).To(Equal(1))
+}
+
+func TestRetry_DefaultPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.Retry(context.Background(), nil, func(_ context.Context) error {
+		return nil
+	})).To(Succeed())
+
+	g.Expect(errorz.Retry(context.Background(), &errorz.RetryPolicy{MaxAttempts: 2}, func(_ context.Context) error {
+		return errorz.Unavailablef("e")
+	})).To(MatchError("retry failed after 2 attempt(s): e"))
+}
+
+func TestRetry1(t *testing.T) {
+	g := NewWithT(t)
+	c := newFakeRetryClock()
+	calls := 0
+
+	out, err := errorz.Retry1(context.Background(), c.newPolicy(), func(_ context.Context) (string, error) {
+		calls++
+		if calls < 2 {
+			return "x", errorz.Unavailablef("e")
+		}
+		return "v", nil
+	})
+	g.Expect(err).To(Succeed())
+	g.Expect(out).To(Equal("v"))
+
+	out, err = errorz.Retry1(context.Background(), c.newPolicy(), func(_ context.Context) (string, error) {
+		return "x", errorz.NotFoundf("e")
+	})
+	g.Expect(err).To(MatchError("retry failed after 1 attempt(s): e"))
+	g.Expect(out).To(Equal(""))
+}
@@ -0,0 +1,3 @@
+// Package sentryz converts errors to Sentry-compatible event payloads, and delivers them using a pluggable transport.
+// It does not send anything over the network by itself.
+package sentryz
@@ -0,0 +1,48 @@
+package sentryz
+
+import (
+	"time"
+)
+
+// Event describes a Sentry event payload. Only the fields populated by [*Exporter] are included.
+type Event struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   time.Time         `json:"timestamp"`
+	Platform    string            `json:"platform"`
+	Level       string            `json:"level"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	Message     string            `json:"message,omitempty"`
+	Exception   *Exception        `json:"exception,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Extra       map[string]any    `json:"extra,omitempty"`
+	Fingerprint []string          `json:"fingerprint,omitempty"`
+}
+
+// Exception describes the exception interface of a Sentry event.
+type Exception struct {
+	Values []*ExceptionValue `json:"values"`
+}
+
+// ExceptionValue describes a single exception in the exception interface of a Sentry event.
+type ExceptionValue struct {
+	Type       string      `json:"type"`
+	Value      string      `json:"value"`
+	Stacktrace *Stacktrace `json:"stacktrace,omitempty"`
+}
+
+// Stacktrace describes the stack trace of an exception, with the oldest frame first.
+type Stacktrace struct {
+	Frames []*Frame `json:"frames"`
+}
+
+// Frame describes a single frame in a stack trace.
+type Frame struct {
+	Function string `json:"function,omitempty"`
+	Module   string `json:"module,omitempty"`
+	Filename string `json:"filename,omitempty"`
+	AbsPath  string `json:"abs_path,omitempty"`
+	Lineno   int    `json:"lineno,omitempty"`
+	InApp    bool   `json:"in_app"`
+}
@@ -0,0 +1,174 @@
+package sentryz
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/idz"
+)
+
+// Exporter converts errors to Sentry events and delivers them using a [Transport].
+type Exporter struct {
+	transport   Transport
+	serverName  string
+	release     string
+	environment string
+	now         func() time.Time
+	newEventID  func() string
+}
+
+// NewExporter initializes a new [*Exporter].
+func NewExporter(transport Transport) *Exporter {
+	return &Exporter{
+		transport:   transport,
+		serverName:  "",
+		release:     "",
+		environment: "",
+		now:         time.Now,
+		newEventID:  newEventID,
+	}
+}
+
+// SetServerName sets the server name included in events.
+func (e *Exporter) SetServerName(serverName string) *Exporter {
+	e.serverName = serverName
+	return e
+}
+
+// SetRelease sets the release included in events.
+func (e *Exporter) SetRelease(release string) *Exporter {
+	e.release = release
+	return e
+}
+
+// SetEnvironment sets the environment included in events.
+func (e *Exporter) SetEnvironment(environment string) *Exporter {
+	e.environment = environment
+	return e
+}
+
+// SetNow sets the function used to generate event timestamps.
+func (e *Exporter) SetNow(now func() time.Time) *Exporter {
+	e.now = now
+	return e
+}
+
+// SetNewEventID sets the function used to generate event IDs.
+func (e *Exporter) SetNewEventID(newEventID func() string) *Exporter {
+	e.newEventID = newEventID
+	return e
+}
+
+// NewEvent converts the error to an [*Event]. The event contains a single exception, whose type and value are the
+// error name and message as returned by [errorz.GetSummary]. If the error has been wrapped, its frames are included
+// (oldest first), with user code frames marked as "in_app". Details are mapped to extra, exported metadata (see
+// [errorz.MetadataExportMetadata]) and HTTP status to tags, and the result of [errorz.Fingerprint] to the fingerprint.
+// Returns nil if err is nil.
+func (e *Exporter) NewEvent(err error) *Event {
+	if err == nil {
+		return nil
+	}
+
+	s := errorz.GetSummaryWithOptions(err, true, &errorz.SummaryOptions{
+		IncludeFingerprint: true,
+		Audience:           errorz.SummaryAudienceInternal,
+		ServerErrorMessage: "",
+		MessageCatalog:     nil,
+		Language:           "",
+	})
+
+	exceptionType := s.Name
+	if exceptionType == "" {
+		exceptionType = "error"
+	}
+
+	event := &Event{
+		EventID:     e.newEventID(),
+		Timestamp:   e.now().UTC(),
+		Platform:    "go",
+		Level:       "error",
+		ServerName:  e.serverName,
+		Release:     e.release,
+		Environment: e.environment,
+		Message:     s.Message,
+		Exception: &Exception{
+			Values: []*ExceptionValue{
+				{
+					Type:       exceptionType,
+					Value:      s.Message,
+					Stacktrace: newStacktrace(err, s),
+				},
+			},
+		},
+		Tags:        make(map[string]string),
+		Extra:       s.Details,
+		Fingerprint: []string{s.Fingerprint},
+	}
+
+	for k, v := range s.Metadata {
+		event.Tags[k] = fmt.Sprintf("%v", v)
+	}
+
+	if s.HTTPStatus != 0 {
+		event.Tags["http.status_code"] = fmt.Sprintf("%v", s.HTTPStatus)
+	}
+
+	return event
+}
+
+// Export converts the error to an [*Event] using [Exporter.NewEvent] and sends it using the [Transport]. It returns the
+// event ID, or an empty string if err is nil.
+func (e *Exporter) Export(ctx context.Context, err error) (string, error) {
+	event := e.NewEvent(err)
+	if event == nil {
+		return "", nil
+	}
+
+	if err := e.transport.Send(ctx, event); err != nil {
+		return "", errorz.Wrap(err)
+	}
+
+	return event.EventID, nil
+}
+
+func newStacktrace(err error, s *errorz.Summary) *Stacktrace {
+	if len(s.Components) == 0 || s.Components[0].Name != "[wrap]" {
+		return nil
+	}
+
+	errorzFrames := errorz.GetFrames(err)
+	if len(errorzFrames) == 0 {
+		return nil
+	}
+
+	frames := make([]*Frame, 0, len(errorzFrames))
+
+	for _, f := range slices.Backward(errorzFrames) {
+		fileName := ""
+		if f.File != "" {
+			fileName = filepath.Base(f.File)
+		}
+
+		frames = append(frames, &Frame{
+			Function: f.Function,
+			Module:   f.Package,
+			Filename: fileName,
+			AbsPath:  f.File,
+			Lineno:   f.Line,
+			InApp:    f.UserCode,
+		})
+	}
+
+	return &Stacktrace{
+		Frames: frames,
+	}
+}
+
+func newEventID() string {
+	return strings.ReplaceAll(idz.MustNewRandomUUID(), "-", "")
+}
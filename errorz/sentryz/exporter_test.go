@@ -0,0 +1,110 @@
+package sentryz_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/sentryz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+var (
+	testRegionKey = errorz.NewMetadataKey[string]("region", errorz.MetadataExportMetadata)
+)
+
+func newTestExporter(transport sentryz.Transport) *sentryz.Exporter {
+	return sentryz.NewExporter(transport).
+		SetServerName("host").
+		SetRelease("v1").
+		SetEnvironment("test").
+		SetNow(func() time.Time { return time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC) }).
+		SetNewEventID(func() string { return "0123456789abcdef0123456789abcdef" })
+}
+
+func TestExporter_NewEvent(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Wrap(fmt.Errorf("e"), &terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "o",
+		Name:         "mock",
+		HTTPStatus:   503,
+		Details:      map[string]any{"k": "v", "token": "t"},
+	})
+	testRegionKey.Set(err, "us")
+
+	event := newTestExporter(sentryz.NewMemoryTransport()).NewEvent(err)
+	g.Expect(event.EventID).To(Equal("0123456789abcdef0123456789abcdef"))
+	g.Expect(event.Timestamp).To(Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	g.Expect(event.Platform).To(Equal("go"))
+	g.Expect(event.Level).To(Equal("error"))
+	g.Expect(event.ServerName).To(Equal("host"))
+	g.Expect(event.Release).To(Equal("v1"))
+	g.Expect(event.Environment).To(Equal("test"))
+	g.Expect(event.Message).To(Equal("o: e"))
+	g.Expect(event.Tags).To(Equal(map[string]string{"region": "us", "http.status_code": "503"}))
+	g.Expect(event.Extra).To(Equal(map[string]any{"k": "v", "token": "[redacted]"}))
+	g.Expect(event.Fingerprint).To(HaveExactElements(errorz.Fingerprint(err)))
+
+	g.Expect(event.Exception.Values).To(HaveLen(1))
+	g.Expect(event.Exception.Values[0].Type).To(Equal("mock"))
+	g.Expect(event.Exception.Values[0].Value).To(Equal("o: e"))
+
+	frames := event.Exception.Values[0].Stacktrace.Frames
+	g.Expect(frames).ToNot(BeEmpty())
+	g.Expect(frames[len(frames)-1].Function).To(Equal("TestExporter_NewEvent"))
+	g.Expect(frames[len(frames)-1].Module).To(Equal("github.com/ibrt/golang-utils/errorz/sentryz_test"))
+	g.Expect(frames[len(frames)-1].Filename).To(Equal("exporter_test.go"))
+	g.Expect(frames[len(frames)-1].AbsPath).To(HaveSuffix("/errorz/sentryz/exporter_test.go"))
+	g.Expect(frames[len(frames)-1].Lineno).To(BeNumerically(">", 0))
+	g.Expect(frames[len(frames)-1].InApp).To(BeTrue())
+
+	buf, mErr := json.Marshal(event)
+	g.Expect(mErr).To(Succeed())
+	g.Expect(string(buf)).To(And(
+		ContainSubstring(`"event_id":"0123456789abcdef0123456789abcdef"`),
+		ContainSubstring(`"timestamp":"2024-01-01T00:00:00Z"`),
+		ContainSubstring(`"exception":{"values":[{"type":"mock","value":"o: e","stacktrace":{"frames":[`),
+		ContainSubstring(`"in_app":true`)))
+}
+
+func TestExporter_NewEvent_Unwrapped(t *testing.T) {
+	g := NewWithT(t)
+
+	event := sentryz.NewExporter(sentryz.NewMemoryTransport()).NewEvent(fmt.Errorf("e"))
+	g.Expect(event.EventID).To(MatchRegexp("^[0-9a-f]{32}$"))
+	g.Expect(event.Timestamp).To(BeTemporally("~", time.Now(), time.Minute))
+	g.Expect(event.Exception.Values[0].Type).To(Equal("error"))
+	g.Expect(event.Exception.Values[0].Value).To(Equal("e"))
+	g.Expect(event.Exception.Values[0].Stacktrace).To(BeNil())
+	g.Expect(event.Tags).To(BeEmpty())
+
+	g.Expect(sentryz.NewExporter(sentryz.NewMemoryTransport()).NewEvent(nil)).To(BeNil())
+}
+
+func TestExporter_Export(t *testing.T) {
+	g := NewWithT(t)
+
+	transport := sentryz.NewMemoryTransport()
+	exporter := newTestExporter(transport)
+
+	eventID, err := exporter.Export(context.Background(), errorz.Errorf("e"))
+	g.Expect(err).To(Succeed())
+	g.Expect(eventID).To(Equal("0123456789abcdef0123456789abcdef"))
+	g.Expect(transport.GetEvents()).To(HaveLen(1))
+	g.Expect(transport.GetEvents()[0].Message).To(Equal("e"))
+
+	eventID, err = exporter.Export(context.Background(), nil)
+	g.Expect(err).To(Succeed())
+	g.Expect(eventID).To(BeEmpty())
+	g.Expect(transport.GetEvents()).To(HaveLen(1))
+
+	eventID, err = newTestExporter(sentryz.NewFileTransport(t.TempDir())).Export(context.Background(), fmt.Errorf("e"))
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(eventID).To(BeEmpty())
+}
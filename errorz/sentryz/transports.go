@@ -0,0 +1,101 @@
+package sentryz
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+// Transport delivers events produced by [*Exporter].
+type Transport interface {
+	// Send delivers the given event.
+	Send(ctx context.Context, event *Event) error
+}
+
+var (
+	_ Transport = (*MemoryTransport)(nil)
+)
+
+// MemoryTransport is a [Transport] that keeps events in memory, e.g. for testing.
+type MemoryTransport struct {
+	m      *sync.Mutex
+	events []*Event
+}
+
+// NewMemoryTransport initializes a new [*MemoryTransport].
+func NewMemoryTransport() *MemoryTransport {
+	return &MemoryTransport{
+		m:      &sync.Mutex{},
+		events: make([]*Event, 0),
+	}
+}
+
+// Send implements the [Transport] interface.
+func (t *MemoryTransport) Send(_ context.Context, event *Event) error {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.events = append(t.events, event)
+	return nil
+}
+
+// GetEvents returns the events sent so far, in order.
+func (t *MemoryTransport) GetEvents() []*Event {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	return slices.Clone(t.events)
+}
+
+// Reset removes all events.
+func (t *MemoryTransport) Reset() {
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	t.events = make([]*Event, 0)
+}
+
+var (
+	_ Transport = (*FileTransport)(nil)
+)
+
+// FileTransport is a [Transport] that appends events to a file, one JSON-encoded event per line.
+type FileTransport struct {
+	m        *sync.Mutex
+	filePath string
+}
+
+// NewFileTransport initializes a new [*FileTransport]. The file is created on first use if it does not exist.
+func NewFileTransport(filePath string) *FileTransport {
+	return &FileTransport{
+		m:        &sync.Mutex{},
+		filePath: filePath,
+	}
+}
+
+// Send implements the [Transport] interface.
+func (t *FileTransport) Send(_ context.Context, event *Event) (outErr error) {
+	buf, err := json.Marshal(event)
+	if err != nil {
+		return errorz.Wrap(err)
+	}
+
+	t.m.Lock()
+	defer t.m.Unlock()
+
+	fd, err := os.OpenFile(t.filePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return errorz.Wrap(err)
+	}
+	defer errorz.CloseInto(&outErr, fd)
+
+	if _, err := fd.Write(append(buf, '\n')); err != nil {
+		return errorz.Wrap(err)
+	}
+
+	return nil
+}
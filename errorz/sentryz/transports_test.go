@@ -0,0 +1,54 @@
+package sentryz_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz/sentryz"
+)
+
+func TestMemoryTransport(t *testing.T) {
+	g := NewWithT(t)
+
+	transport := sentryz.NewMemoryTransport()
+	g.Expect(transport.GetEvents()).To(BeEmpty())
+
+	g.Expect(transport.Send(context.Background(), &sentryz.Event{EventID: "1"})).To(Succeed())
+	g.Expect(transport.Send(context.Background(), &sentryz.Event{EventID: "2"})).To(Succeed())
+	g.Expect(transport.GetEvents()).To(HaveExactElements(
+		&sentryz.Event{EventID: "1"},
+		&sentryz.Event{EventID: "2"}))
+
+	transport.Reset()
+	g.Expect(transport.GetEvents()).To(BeEmpty())
+}
+
+func TestFileTransport(t *testing.T) {
+	g := NewWithT(t)
+
+	filePath := filepath.Join(t.TempDir(), "events.jsonl")
+	transport := sentryz.NewFileTransport(filePath)
+
+	g.Expect(transport.Send(context.Background(), &sentryz.Event{EventID: "1"})).To(Succeed())
+	g.Expect(transport.Send(context.Background(), &sentryz.Event{EventID: "2"})).To(Succeed())
+
+	buf, err := os.ReadFile(filePath)
+	g.Expect(err).To(Succeed())
+
+	lines := strings.Split(strings.TrimSuffix(string(buf), "\n"), "\n")
+	g.Expect(lines).To(HaveLen(2))
+
+	event := &sentryz.Event{}
+	g.Expect(json.Unmarshal([]byte(lines[1]), event)).To(Succeed())
+	g.Expect(event.EventID).To(Equal("2"))
+
+	g.Expect(transport.Send(context.Background(), &sentryz.Event{Extra: map[string]any{"k": func() {}}})).
+		ToNot(Succeed())
+	g.Expect(sentryz.NewFileTransport(t.TempDir()).Send(context.Background(), &sentryz.Event{})).ToNot(Succeed())
+}
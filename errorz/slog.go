@@ -0,0 +1,195 @@
+package errorz
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+)
+
+// SlogOptions describes options for converting errors to [slog.Value].
+type SlogOptions struct {
+	// MaxFrames is the maximum number of frames to include, or a negative number for no limit.
+	MaxFrames int
+}
+
+var (
+	defaultSlogOptions = &SlogOptions{
+		MaxFrames: 10,
+	}
+)
+
+// DefaultSlogOptions is a default, shared instance of [*SlogOptions], used by wrapped errors implementing the
+// [slog.LogValuer] interface.
+var (
+	DefaultSlogOptions = defaultSlogOptions
+)
+
+// RestoreDefaultSlogOptions restores the default value of [DefaultSlogOptions].
+func RestoreDefaultSlogOptions() {
+	DefaultSlogOptions = defaultSlogOptions
+}
+
+// SlogValue converts the error to a [slog.Value] group containing the same information as [GetSummary], plus metadata
+// (redacted as in [SummaryAudienceInternal]) and frames if the error has been wrapped. If opts is nil,
+// [DefaultSlogOptions] is used.
+func SlogValue(err error, opts *SlogOptions) slog.Value {
+	if err == nil {
+		return slog.GroupValue()
+	}
+
+	if opts == nil {
+		opts = DefaultSlogOptions
+	}
+
+	s := GetSummary(err, false)
+	attrs := make([]slog.Attr, 0, 6)
+
+	if s.Name != "" {
+		attrs = append(attrs, slog.String("name", s.Name))
+	}
+
+	attrs = append(attrs, slog.String("message", s.Message))
+
+	if s.HTTPStatus != 0 {
+		attrs = append(attrs, slog.Int("httpStatus", s.HTTPStatus))
+	}
+
+	if len(s.Details) > 0 {
+		attrs = append(attrs, slog.Attr{
+			Key:   "details",
+			Value: slogMapValue(s.Details),
+		})
+	}
+
+	if e, ok := err.(*wrappedError); ok { //nolint:errorlint
+		if metadata := e.getAllMetadata(); len(metadata) > 0 {
+			m := make(map[string]any, len(metadata))
+			for k, v := range metadata {
+				m[fmt.Sprintf("%v", k)] = v
+			}
+
+			attrs = append(attrs, slog.Attr{
+				Key:   "metadata",
+				Value: slogMapValue(redactMap(m, SummaryAudienceInternal)),
+			})
+		}
+
+		if opts.MaxFrames != 0 {
+			frames := GetFrames(e)
+
+			if opts.MaxFrames > 0 && len(frames) > opts.MaxFrames {
+				frames = frames[:opts.MaxFrames]
+			}
+
+			attrs = append(attrs, slog.Any("frames", frames.ToSummaries()))
+		}
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+func slogMapValue(m map[string]any) slog.Value {
+	attrs := make([]slog.Attr, 0, len(m))
+
+	for _, k := range slices.SortedFunc(maps.Keys(m), cmp.Compare) {
+		attrs = append(attrs, slog.Any(k, m[k]))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+var (
+	_ slog.LogValuer = (*wrappedError)(nil)
+)
+
+// LogValue implements the [slog.LogValuer] interface.
+func (e *wrappedError) LogValue() slog.Value {
+	return SlogValue(e, nil)
+}
+
+var (
+	_ slog.Handler = (*SlogHandler)(nil)
+)
+
+// SlogHandler is a [slog.Handler] that expands any attribute containing an error using [SlogValue], then forwards
+// records to another [slog.Handler].
+type SlogHandler struct {
+	h    slog.Handler
+	opts *SlogOptions
+}
+
+// NewSlogHandler initializes a new [*SlogHandler]. If opts is nil, [DefaultSlogOptions] is used.
+func NewSlogHandler(h slog.Handler, opts *SlogOptions) *SlogHandler {
+	return &SlogHandler{
+		h:    h,
+		opts: opts,
+	}
+}
+
+// Enabled implements the [slog.Handler] interface.
+func (h *SlogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.h.Enabled(ctx, level)
+}
+
+// Handle implements the [slog.Handler] interface.
+func (h *SlogHandler) Handle(ctx context.Context, r slog.Record) error {
+	nr := slog.NewRecord(r.Time, r.Level, r.Message, r.PC)
+
+	r.Attrs(func(a slog.Attr) bool {
+		nr.AddAttrs(h.expandAttr(a))
+		return true
+	})
+
+	return MaybeWrap(h.h.Handle(ctx, nr))
+}
+
+// WithAttrs implements the [slog.Handler] interface.
+func (h *SlogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	expandedAttrs := make([]slog.Attr, 0, len(attrs))
+
+	for _, a := range attrs {
+		expandedAttrs = append(expandedAttrs, h.expandAttr(a))
+	}
+
+	return &SlogHandler{
+		h:    h.h.WithAttrs(expandedAttrs),
+		opts: h.opts,
+	}
+}
+
+// WithGroup implements the [slog.Handler] interface.
+func (h *SlogHandler) WithGroup(name string) slog.Handler {
+	return &SlogHandler{
+		h:    h.h.WithGroup(name),
+		opts: h.opts,
+	}
+}
+
+func (h *SlogHandler) expandAttr(a slog.Attr) slog.Attr {
+	switch a.Value.Kind() {
+	case slog.KindAny, slog.KindLogValuer:
+		if err, ok := a.Value.Any().(error); ok {
+			return slog.Attr{
+				Key:   a.Key,
+				Value: SlogValue(err, h.opts),
+			}
+		}
+	case slog.KindGroup:
+		groupAttrs := a.Value.Group()
+		expandedAttrs := make([]slog.Attr, 0, len(groupAttrs))
+
+		for _, ga := range groupAttrs {
+			expandedAttrs = append(expandedAttrs, h.expandAttr(ga))
+		}
+
+		return slog.Attr{
+			Key:   a.Key,
+			Value: slog.GroupValue(expandedAttrs...),
+		}
+	}
+
+	return a
+}
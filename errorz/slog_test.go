@@ -0,0 +1,92 @@
+package errorz_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+	"github.com/ibrt/golang-utils/errorz/terrorz"
+)
+
+func TestSlogValue(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.SlogValue(nil, nil)).To(Equal(slog.GroupValue()))
+
+	g.Expect(errorz.SlogValue(fmt.Errorf("e"), nil).Group()).To(HaveExactElements(
+		slog.String("message", "e")))
+
+	err := errorz.Wrap(&terrorz.SimpleMockTestDetailedError{
+		ErrorMessage: "e1-err",
+		Name:         "e1",
+		HTTPStatus:   400,
+		Details:      map[string]any{"k2": 2, "k1": 1},
+	})
+	errorz.MaybeSetMetadata(err, "mk", "mv")
+
+	attrs := errorz.SlogValue(err, &errorz.SlogOptions{MaxFrames: 1}).Group()
+	g.Expect(attrs).To(HaveLen(6))
+	g.Expect(attrs[0]).To(Equal(slog.String("name", "e1")))
+	g.Expect(attrs[1]).To(Equal(slog.String("message", "e1-err")))
+	g.Expect(attrs[2]).To(Equal(slog.Int("httpStatus", 400)))
+	g.Expect(attrs[3].Key).To(Equal("details"))
+	g.Expect(attrs[3].Value.Group()).To(HaveExactElements(slog.Any("k1", 1), slog.Any("k2", 2)))
+	g.Expect(attrs[4].Key).To(Equal("metadata"))
+	g.Expect(attrs[4].Value.Group()).To(HaveExactElements(slog.Any("mk", "mv")))
+	g.Expect(attrs[5].Key).To(Equal("frames"))
+	g.Expect(attrs[5].Value.Any()).To(HaveLen(1))
+
+	attrs = errorz.SlogValue(err, &errorz.SlogOptions{MaxFrames: 0}).Group()
+	g.Expect(attrs).To(HaveLen(5))
+
+	attrs = errorz.SlogValue(err, &errorz.SlogOptions{MaxFrames: -1}).Group()
+	g.Expect(attrs).To(HaveLen(6))
+	g.Expect(attrs[5].Value.Any()).To(HaveLen(len(errorz.GetFrames(err))))
+}
+
+func TestWrappedError_LogValue(t *testing.T) {
+	g := NewWithT(t)
+
+	errorz.DefaultSlogOptions = &errorz.SlogOptions{MaxFrames: 1}
+	defer errorz.RestoreDefaultSlogOptions()
+
+	buf := &bytes.Buffer{}
+	slog.New(slog.NewJSONHandler(buf, nil)).Info("msg", "err", errorz.Errorf("e"))
+
+	m := map[string]any{}
+	g.Expect(json.Unmarshal(buf.Bytes(), &m)).To(Succeed())
+	g.Expect(m).To(HaveKeyWithValue("err", HaveKeyWithValue("message", "e")))
+	g.Expect(m).To(HaveKeyWithValue("err", HaveKeyWithValue("frames", HaveLen(1))))
+}
+
+func TestSlogHandler(t *testing.T) {
+	g := NewWithT(t)
+
+	buf := &bytes.Buffer{}
+	h := errorz.NewSlogHandler(slog.NewJSONHandler(buf, nil), &errorz.SlogOptions{MaxFrames: 1})
+	g.Expect(h.Enabled(context.Background(), slog.LevelInfo)).To(BeTrue())
+	g.Expect(h.Enabled(context.Background(), slog.LevelDebug)).To(BeFalse())
+
+	slog.New(h).
+		With("e1", fmt.Errorf("e1")).
+		WithGroup("g").
+		Info("msg",
+			"e2", errorz.Errorf("e2"),
+			slog.Group("g2", "e3", &terrorz.SimpleMockTestDetailedError{ErrorMessage: "e3", Name: "n3"}),
+			"k", "v")
+
+	m := map[string]any{}
+	g.Expect(json.Unmarshal(buf.Bytes(), &m)).To(Succeed())
+	g.Expect(m).To(HaveKeyWithValue("e1", Equal(map[string]any{"message": "e1"})))
+	g.Expect(m).To(HaveKeyWithValue("g", HaveKeyWithValue("e2", HaveKeyWithValue("message", "e2"))))
+	g.Expect(m).To(HaveKeyWithValue("g", HaveKeyWithValue("e2", HaveKeyWithValue("frames", HaveLen(1)))))
+	g.Expect(m).To(HaveKeyWithValue("g", HaveKeyWithValue("g2", HaveKeyWithValue("e3",
+		Equal(map[string]any{"name": "n3", "message": "e3"})))))
+	g.Expect(m).To(HaveKeyWithValue("g", HaveKeyWithValue("k", "v")))
+}
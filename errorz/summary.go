@@ -6,24 +6,78 @@ import (
 
 // Summary presents an error in human-readable, debug form.
 type Summary struct {
-	Name       string         `json:"name,omitempty"`
-	Message    string         `json:"message,omitempty"`
-	HTTPStatus int            `json:"httpStatus,omitempty"`
-	Details    map[string]any `json:"details,omitempty"`
-	Components []*Summary     `json:"components,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	Message     string         `json:"message,omitempty"`
+	UserMessage string         `json:"userMessage,omitempty"`
+	HTTPStatus  int            `json:"httpStatus,omitempty"`
+	Details     map[string]any `json:"details,omitempty"`
+	Metadata    map[string]any `json:"metadata,omitempty"`
+	Fingerprint string         `json:"fingerprint,omitempty"`
+	WrapTrail   []string       `json:"wrapTrail,omitempty"`
+	Snippets    []*Snippet     `json:"snippets,omitempty"`
+	Components  []*Summary     `json:"components,omitempty"`
 }
 
-// GetSummary returns a summary of the error.
+// SummaryOptions describes options for [GetSummaryWithOptions].
+type SummaryOptions struct {
+	// IncludeFingerprint includes the result of [Fingerprint] in the top-level summary.
+	IncludeFingerprint bool
+	// Audience determines how sensitive details are redacted (see [IsSensitive]).
+	Audience SummaryAudience
+	// ServerErrorMessage, if not empty, replaces all messages if the summary HTTP status is 5xx.
+	ServerErrorMessage string
+	// MessageCatalog, if not nil, is used to fill [Summary.UserMessage] in the top-level summary.
+	MessageCatalog *MessageCatalog
+	// Language is the language of [Summary.UserMessage] (see [MessageCatalog.GetMessage]).
+	Language string
+}
+
+var (
+	defaultSummaryOptions = &SummaryOptions{
+		IncludeFingerprint: false,
+		Audience:           SummaryAudienceInternal,
+		ServerErrorMessage: "",
+		MessageCatalog:     nil,
+		Language:           "",
+	}
+)
+
+// DefaultSummaryOptions is a default, shared instance of [*SummaryOptions], used by [GetSummary].
+var (
+	DefaultSummaryOptions = defaultSummaryOptions
+)
+
+// RestoreDefaultSummaryOptions restores the default value of [DefaultSummaryOptions].
+func RestoreDefaultSummaryOptions() {
+	DefaultSummaryOptions = defaultSummaryOptions
+}
+
+// GetSummary returns a summary of the error, using [DefaultSummaryOptions].
 func GetSummary(err error, includeComponents bool) *Summary {
+	return GetSummaryWithOptions(err, includeComponents, DefaultSummaryOptions)
+}
+
+// GetSummaryWithOptions is like [GetSummary] but uses the given options. If opts is nil, [DefaultSummaryOptions] is
+// used.
+func GetSummaryWithOptions(err error, includeComponents bool, opts *SummaryOptions) *Summary {
 	if err == nil {
 		return nil
 	}
 
+	if opts == nil {
+		opts = DefaultSummaryOptions
+	}
+
 	s := &Summary{
-		Name:       "",
-		Message:    err.Error(),
-		HTTPStatus: 0,
-		Details:    make(map[string]any),
+		Name:        "",
+		Message:     err.Error(),
+		UserMessage: "",
+		HTTPStatus:  0,
+		Details:     make(map[string]any),
+		Metadata:    nil,
+		Fingerprint: "",
+		WrapTrail:   nil,
+		Snippets:    nil,
 		Components: []*Summary{
 			getSummaryInternal(err),
 		},
@@ -41,8 +95,28 @@ func GetSummary(err error, includeComponents bool) *Summary {
 		for k, v := range iS.Details {
 			s.Details[k] = v
 		}
+
+		for k, v := range iS.Metadata {
+			if s.Metadata == nil {
+				s.Metadata = make(map[string]any)
+			}
+			s.Metadata[k] = v
+		}
+
+		s.WrapTrail = append(s.WrapTrail, iS.WrapTrail...)
+		s.Snippets = append(s.Snippets, iS.Snippets...)
 	})
 
+	redactSummary(s, opts)
+
+	if opts.MessageCatalog != nil {
+		s.UserMessage, _ = opts.MessageCatalog.GetMessage(opts.Language, s.Name, s.Details)
+	}
+
+	if opts.IncludeFingerprint {
+		s.Fingerprint = Fingerprint(err)
+	}
+
 	if !includeComponents {
 		s.Components = nil
 	}
@@ -51,22 +125,7 @@ func GetSummary(err error, includeComponents bool) *Summary {
 }
 
 func getSummaryInternal(err error) *Summary {
-	s := &Summary{
-		Name:       maybeGetName(err),
-		Message:    err.Error(),
-		HTTPStatus: maybeGetHTTPStatus(err),
-		Details:    maybeGetDetails(err),
-		Components: nil,
-	}
-
-	switch {
-	case isWrapError(err):
-		s.Name = "[wrap]"
-		s.Message = ""
-	case isJoinError(err):
-		s.Name = "[join]"
-		s.Message = ""
-	}
+	s := getComponentSummary(err)
 
 	switch e := err.(type) { //nolint:errorlint
 	case UnwrapMulti:
@@ -84,6 +143,39 @@ func getSummaryInternal(err error) *Summary {
 	return s
 }
 
+func getComponentSummary(err error) *Summary {
+	s := &Summary{
+		Name:        maybeGetName(err),
+		Message:     err.Error(),
+		UserMessage: "",
+		HTTPStatus:  maybeGetHTTPStatus(err),
+		Details:     maybeGetDetails(err),
+		Metadata:    nil,
+		Fingerprint: "",
+		WrapTrail:   nil,
+		Snippets:    nil,
+		Components:  nil,
+	}
+
+	switch {
+	case isWrapError(err):
+		s.Name = "[wrap]"
+		s.Message = ""
+		s.Details, s.Metadata = getExportedMetadata(err)
+
+		if trail := GetWrapTrail(err); len(trail) > 0 {
+			s.WrapTrail = trail.ToSummaries()
+		}
+
+		s.Snippets = getSnippets(err)
+	case isJoinError(err):
+		s.Name = "[join]"
+		s.Message = ""
+	}
+
+	return s
+}
+
 func walkSummary(s *Summary, f func(iS *Summary)) {
 	for _, cS := range s.Components {
 		walkSummary(cS, f)
@@ -99,6 +191,10 @@ func maybeGetName(err error) string {
 		}
 	}
 
+	if _, ok := err.(*RemoteError); ok { //nolint:errorlint
+		return ""
+	}
+
 	if !isWrapError(err) && !isGenericError(err) {
 		return reflect.TypeOf(err).String()
 	}
@@ -217,3 +217,19 @@ func TestGetSummary(t *testing.T) {
 			},
 		}))
 }
+
+func TestGetSummaryWithOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	err := errorz.Errorf("e")
+	g.Expect(errorz.GetSummaryWithOptions(nil, true, nil)).To(BeNil())
+	g.Expect(errorz.GetSummaryWithOptions(err, false, nil).Fingerprint).To(BeEmpty())
+
+	s := errorz.GetSummaryWithOptions(err, true, &errorz.SummaryOptions{IncludeFingerprint: true})
+	g.Expect(s.Fingerprint).To(Equal(errorz.Fingerprint(err)))
+	g.Expect(s.Components[0].Fingerprint).To(BeEmpty())
+
+	errorz.DefaultSummaryOptions = &errorz.SummaryOptions{IncludeFingerprint: true}
+	defer errorz.RestoreDefaultSummaryOptions()
+	g.Expect(errorz.GetSummary(err, false).Fingerprint).To(Equal(errorz.Fingerprint(err)))
+}
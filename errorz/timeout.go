@@ -0,0 +1,265 @@
+package errorz
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	timeoutStackInitialSize = 64 * 1024
+	timeoutStackMaxSize     = 1024 * 1024
+)
+
+// CatchTimeout0 is like [Catch0Ctx], but runs f in a separate goroutine, passing it a context derived from ctx with
+// timeout d. If f does not return before the derived context is done (e.g. because it ignores it), CatchTimeout0
+// returns a wrapped [*TimeoutError] without waiting for it, and f keeps running in the background.
+func CatchTimeout0(ctx context.Context, d time.Duration, f func(ctx context.Context) error) error {
+	_, err := catchTimeout(ctx, d, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, f(ctx)
+	})
+	return err
+}
+
+// CatchTimeout1 is like [CatchTimeout0] but for a "func(context.Context) (T, error)" closure.
+func CatchTimeout1[T any](ctx context.Context, d time.Duration, f func(ctx context.Context) (T, error)) (T, error) {
+	return catchTimeout(ctx, d, f)
+}
+
+// CatchTimeout2 is like [CatchTimeout0] but for a "func(context.Context) (T1, T2, error)" closure.
+func CatchTimeout2[T1 any, T2 any](
+	ctx context.Context,
+	d time.Duration,
+	f func(ctx context.Context) (T1, T2, error),
+) (T1, T2, error) {
+	type result struct {
+		v1 T1
+		v2 T2
+	}
+
+	r, err := catchTimeout(ctx, d, func(ctx context.Context) (result, error) {
+		v1, v2, err := f(ctx)
+		return result{v1: v1, v2: v2}, err
+	})
+
+	return r.v1, r.v2, err
+}
+
+type timeoutResult[T any] struct {
+	v   T
+	err error
+}
+
+func catchTimeout[T any](ctx context.Context, d time.Duration, f func(ctx context.Context) (T, error)) (T, error) {
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+
+	resultCh := make(chan *timeoutResult[T], 1)
+	done := make(chan struct{})
+	completed := &atomic.Bool{}
+	goroutineID := &atomic.Pointer[string]{}
+
+	go func() {
+		defer close(done)
+		defer completed.Store(true)
+
+		id := getGoroutineID()
+		goroutineID.Store(&id)
+
+		var zero T
+
+		r := &timeoutResult[T]{
+			v:   zero,
+			err: nil,
+		}
+
+		defer func() {
+			if rErr := MaybeWrapRecover(recover()); rErr != nil {
+				r.v = zero
+				r.err = rErr
+			}
+
+			resultCh <- r
+		}()
+
+		r.v, r.err = f(ctx)
+	}()
+
+	select {
+	case r := <-resultCh:
+		return r.v, catchTimeoutResult(ctx, r.err, start)
+	case <-ctx.Done():
+		select {
+		case r := <-resultCh:
+			return r.v, catchTimeoutResult(ctx, r.err, start)
+		default:
+		}
+	}
+
+	tErr := &TimeoutError{
+		timeout:   d,
+		elapsed:   time.Since(start),
+		cause:     ctx.Err(),
+		stack:     "",
+		done:      done,
+		completed: completed,
+	}
+
+	if id := goroutineID.Load(); id != nil {
+		tErr.stack = getGoroutineStack(*id)
+	}
+
+	var zero T
+	err := Wrap(tErr)
+	maybeRunHooks(HookTypeCatch, err)
+	return zero, err
+}
+
+func catchTimeoutResult(ctx context.Context, err error, start time.Time) error {
+	err = maybeWrapCtx(ctx, err, start)
+	maybeRunHooks(HookTypeCatch, err)
+	return err
+}
+
+func getGoroutineID() string {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+
+	// The first line looks like "goroutine 123 [running]:".
+	if fields := bytes.Fields(buf); len(fields) >= 2 {
+		return string(fields[1])
+	}
+
+	return ""
+}
+
+func getGoroutineStack(id string) string {
+	if id == "" {
+		return ""
+	}
+
+	buf := make([]byte, timeoutStackInitialSize)
+
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) || len(buf) >= timeoutStackMaxSize {
+			buf = buf[:n]
+			break
+		}
+
+		buf = make([]byte, 2*len(buf))
+	}
+
+	prefix := "goroutine " + id + " ["
+
+	for _, stack := range strings.Split(string(buf), "\n\n") {
+		if strings.HasPrefix(stack, prefix) {
+			return strings.TrimSpace(stack)
+		}
+	}
+
+	return ""
+}
+
+var (
+	_ error           = (*TimeoutError)(nil)
+	_ ErrorName       = (*TimeoutError)(nil)
+	_ ErrorDetails    = (*TimeoutError)(nil)
+	_ ErrorHTTPStatus = (*TimeoutError)(nil)
+	_ ErrorKind       = (*TimeoutError)(nil)
+)
+
+// TimeoutError describes a function run by one of the "CatchTimeout*" functions which did not return before its
+// context was done. The function may still be running, see [TimeoutError.IsCompleted] and [TimeoutError.Done].
+type TimeoutError struct {
+	timeout   time.Duration
+	elapsed   time.Duration
+	cause     error
+	stack     string
+	done      <-chan struct{}
+	completed *atomic.Bool
+}
+
+// Error implements the error interface.
+func (e *TimeoutError) Error() string {
+	if e.isCanceled() {
+		return fmt.Sprintf("call canceled after %v: %v", e.elapsed, e.cause)
+	}
+
+	return fmt.Sprintf("call did not complete within %v: %v", e.timeout, e.cause)
+}
+
+// GetErrorName implements the [ErrorName] interface.
+func (e *TimeoutError) GetErrorName() string {
+	if e.isCanceled() {
+		return "canceled-error"
+	}
+
+	return "timeout-error"
+}
+
+// GetErrorDetails implements the [ErrorDetails] interface. The "completed" detail reflects the state of the function
+// at the time the details are obtained. The stack is not included, see [TimeoutError.GetStack].
+func (e *TimeoutError) GetErrorDetails() map[string]any {
+	return map[string]any{
+		"timeout":   e.timeout.String(),
+		"elapsed":   e.elapsed.String(),
+		"completed": e.IsCompleted(),
+	}
+}
+
+// GetErrorHTTPStatus implements the [ErrorHTTPStatus] interface.
+func (e *TimeoutError) GetErrorHTTPStatus() int {
+	return e.GetErrorKind().GetHTTPStatus()
+}
+
+// GetErrorKind implements the [ErrorKind] interface.
+func (e *TimeoutError) GetErrorKind() *Kind {
+	if e.isCanceled() {
+		return KindCanceled
+	}
+
+	return KindDeadlineExceeded
+}
+
+// Is allows [errors.Is] to match the context error, i.e. [context.DeadlineExceeded] or [context.Canceled].
+func (e *TimeoutError) Is(target error) bool {
+	return errors.Is(e.cause, target)
+}
+
+// GetTimeout returns the timeout.
+func (e *TimeoutError) GetTimeout() time.Duration {
+	return e.timeout
+}
+
+// GetElapsed returns the time elapsed between the start of the call and the error.
+func (e *TimeoutError) GetElapsed() time.Duration {
+	return e.elapsed
+}
+
+// GetStack returns the stack of the goroutine running the function at the time of the error, as formatted by
+// [runtime.Stack], or an empty string if it could not be obtained (e.g. because the combined stacks of all goroutines
+// exceed 1MB). It may contain sensitive information and it is intentionally not included in the error details.
+func (e *TimeoutError) GetStack() string {
+	return e.stack
+}
+
+// IsCompleted returns true if the function has returned (or panicked) since the error was created.
+func (e *TimeoutError) IsCompleted() bool {
+	return e.completed.Load()
+}
+
+// Done returns a channel which is closed when the function returns (or panics).
+func (e *TimeoutError) Done() <-chan struct{} {
+	return e.done
+}
+
+func (e *TimeoutError) isCanceled() bool {
+	return errors.Is(e.cause, context.Canceled)
+}
@@ -0,0 +1,154 @@
+package errorz_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+func blockingTestCall(release <-chan struct{}) {
+	<-release
+}
+
+func TestCatchTimeout0(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.CatchTimeout0(context.Background(), time.Second, func(_ context.Context) error {
+		return nil
+	})).To(Succeed())
+
+	err := errorz.CatchTimeout0(context.Background(), time.Second, func(_ context.Context) error {
+		return fmt.Errorf("e")
+	})
+	g.Expect(err).To(MatchError("e"))
+	g.Expect(errorz.GetFrames(err)).ToNot(BeEmpty())
+
+	err = errorz.CatchTimeout0(context.Background(), time.Second, func(_ context.Context) error {
+		panic("p")
+	})
+	g.Expect(err).To(MatchError("p"))
+	g.Expect(errorz.GetFrames(err)[0].ShortLocation).To(Equal("errorz_test.TestCatchTimeout0.func3"))
+
+	err = errorz.CatchTimeout0(context.Background(), 10*time.Millisecond, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+
+	err = errorz.CatchTimeout0(context.Background(), time.Second, func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		wErr := errorz.Wrap(ctx.Err())
+		errorz.MaybeSetMetadata(wErr, "k", "v")
+		return wErr
+	})
+	g.Expect(err).To(MatchError(context.Canceled))
+	g.Expect(errorz.MustGetMetadata[string](err, "k")).To(Equal("v"))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindCanceled))
+}
+
+func TestCatchTimeout0_Timeout(t *testing.T) {
+	g := NewWithT(t)
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	err := errorz.CatchTimeout0(context.Background(), 50*time.Millisecond, func(_ context.Context) error {
+		close(started)
+		blockingTestCall(release)
+		return nil
+	})
+	g.Expect(err).To(MatchError("call did not complete within 50ms: context deadline exceeded"))
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindDeadlineExceeded))
+	g.Expect(errorz.GetFrames(err)[0].ShortLocation).To(Equal("errorz_test.TestCatchTimeout0_Timeout"))
+	<-started
+
+	tErr, ok := errorz.As[*errorz.TimeoutError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(tErr.GetTimeout()).To(Equal(50 * time.Millisecond))
+	g.Expect(tErr.GetElapsed()).To(BeNumerically(">=", 50*time.Millisecond))
+	g.Expect(tErr.GetStack()).To(HavePrefix("goroutine "))
+	g.Expect(tErr.GetStack()).To(ContainSubstring("blockingTestCall"))
+	g.Expect(tErr.IsCompleted()).To(BeFalse())
+
+	s := errorz.GetSummary(err, false)
+	g.Expect(s.Name).To(Equal("timeout-error"))
+	g.Expect(s.HTTPStatus).To(Equal(504))
+	g.Expect(s.Details).To(HaveKeyWithValue("timeout", "50ms"))
+	g.Expect(s.Details).To(HaveKeyWithValue("completed", false))
+	g.Expect(s.Details).ToNot(HaveKey("stack"))
+
+	close(release)
+	<-tErr.Done()
+	g.Expect(tErr.IsCompleted()).To(BeTrue())
+	g.Expect(errorz.GetSummary(err, false).Details).To(HaveKeyWithValue("completed", true))
+}
+
+func TestCatchTimeout0_Canceled(t *testing.T) {
+	g := NewWithT(t)
+	release := make(chan struct{})
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	err := errorz.CatchTimeout0(ctx, time.Hour, func(_ context.Context) error {
+		blockingTestCall(release)
+		return nil
+	})
+	g.Expect(err.Error()).To(MatchRegexp(`^call canceled after \S+: context canceled$`))
+	g.Expect(err).To(MatchError(context.Canceled))
+	g.Expect(errorz.KindOf(err)).To(BeIdenticalTo(errorz.KindCanceled))
+
+	s := errorz.GetSummary(err, false)
+	g.Expect(s.Name).To(Equal("canceled-error"))
+	g.Expect(s.HTTPStatus).To(Equal(499))
+}
+
+func TestCatchTimeout1(t *testing.T) {
+	g := NewWithT(t)
+
+	v, err := errorz.CatchTimeout1(context.Background(), time.Second, func(_ context.Context) (int, error) {
+		return 1, nil
+	})
+	g.Expect(err).To(Succeed())
+	g.Expect(v).To(Equal(1))
+
+	release := make(chan struct{})
+	defer close(release)
+
+	v, err = errorz.CatchTimeout1(context.Background(), time.Millisecond, func(_ context.Context) (int, error) {
+		blockingTestCall(release)
+		return 1, nil
+	})
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	g.Expect(v).To(Equal(0))
+}
+
+func TestCatchTimeout2(t *testing.T) {
+	g := NewWithT(t)
+
+	v1, v2, err := errorz.CatchTimeout2(context.Background(), time.Second, func(_ context.Context) (int, string, error) {
+		return 1, "a", nil
+	})
+	g.Expect(err).To(Succeed())
+	g.Expect(v1).To(Equal(1))
+	g.Expect(v2).To(Equal("a"))
+
+	release := make(chan struct{})
+	defer close(release)
+
+	v1, v2, err = errorz.CatchTimeout2(context.Background(), time.Millisecond, func(_ context.Context) (int, string, error) {
+		blockingTestCall(release)
+		return 1, "a", nil
+	})
+	g.Expect(err).To(MatchError(context.DeadlineExceeded))
+	g.Expect(v1).To(Equal(0))
+	g.Expect(v2).To(Equal(""))
+}
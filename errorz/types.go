@@ -3,6 +3,7 @@ package errorz
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
 	"slices"
 	"strings"
@@ -40,10 +41,11 @@ type UnwrapMulti interface {
 }
 
 var (
-	_ error        = (*valueError)(nil)
-	_ ErrorName    = (*valueError)(nil)
-	_ ErrorDetails = (*valueError)(nil)
-	_ UnwrapSingle = (*valueError)(nil)
+	_ error         = (*valueError)(nil)
+	_ fmt.Formatter = (*valueError)(nil)
+	_ ErrorName     = (*valueError)(nil)
+	_ ErrorDetails  = (*valueError)(nil)
+	_ UnwrapSingle  = (*valueError)(nil)
 )
 
 type valueError struct {
@@ -75,8 +77,9 @@ func (e *valueError) Unwrap() error {
 }
 
 var (
-	_ error       = (*wrappedError)(nil)
-	_ UnwrapMulti = (*wrappedError)(nil)
+	_ error         = (*wrappedError)(nil)
+	_ fmt.Formatter = (*wrappedError)(nil)
+	_ UnwrapMulti   = (*wrappedError)(nil)
 )
 
 // Error implements the error interface.
@@ -84,11 +87,19 @@ func (e *valueError) Error() string {
 	return fmt.Sprintf("%v", e.value)
 }
 
+// Format implements the [fmt.Formatter] interface.
+func (e *valueError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
 type wrappedError struct {
-	m        *sync.Mutex
-	errs     []error
-	frames   Frames
-	metadata map[any]any
+	m            *sync.Mutex
+	errs         []error
+	callers      []uintptr
+	frames       Frames
+	trailCallers [][]uintptr
+	trail        Frames
+	metadata     map[any]any
 }
 
 // Error implements the error interface.
@@ -108,6 +119,11 @@ func (e *wrappedError) Error() string {
 	return w.String()
 }
 
+// Format implements the [fmt.Formatter] interface.
+func (e *wrappedError) Format(s fmt.State, verb rune) {
+	formatError(s, verb, e)
+}
+
 // Unwrap implements the [UnwrapMulti] interface.
 func (e *wrappedError) Unwrap() []error {
 	if e == nil {
@@ -121,6 +137,39 @@ func (e *wrappedError) Unwrap() []error {
 	return errs
 }
 
+func (e *wrappedError) getFrames() Frames {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	if e.frames == nil {
+		e.frames = symbolizeCallers(e.callers)
+		e.callers = nil
+	}
+
+	return e.frames
+}
+
+func (e *wrappedError) addWrapSite(callers []uintptr) {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	e.trailCallers = append(e.trailCallers, callers)
+}
+
+func (e *wrappedError) getWrapTrail() Frames {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	for _, callers := range e.trailCallers {
+		if frames := symbolizeCallers(callers); len(frames) > 0 {
+			e.trail = append(e.trail, frames[0])
+		}
+	}
+
+	e.trailCallers = nil
+	return slices.Clone(e.trail)
+}
+
 func (e *wrappedError) setMetadata(k, v any) {
 	e.m.Lock()
 	defer e.m.Unlock()
@@ -136,6 +185,13 @@ func (e *wrappedError) getMetadata(k any) (any, bool) {
 	return v, ok
 }
 
+func (e *wrappedError) getAllMetadata() map[any]any {
+	e.m.Lock()
+	defer e.m.Unlock()
+
+	return maps.Clone(e.metadata)
+}
+
 var (
 	genericErrorsErrorString     = reflect.TypeOf(fmt.Errorf("e"))
 	genericErrorsErrorStringName = genericErrorsErrorString.String()
@@ -1,6 +1,7 @@
 package errorz
 
 import (
+	"errors"
 	"io"
 )
 
@@ -21,3 +22,57 @@ func MustClose(c io.Closer) {
 		MaybeMustWrap(c.Close())
 	}
 }
+
+// Flusher describes a type with a Flush method which can fail, e.g. a [*bufio.Writer].
+type Flusher interface {
+	// Flush writes any buffered data.
+	Flush() error
+}
+
+// CloseInto calls [io.Closer.Close], merging the returned error (or panic) into *errPtr using [MergeInto]. Handy for
+// the "defer Close" pattern with a named error return, i.e. "defer errorz.CloseInto(&err, c)".
+func CloseInto(errPtr *error, c io.Closer) {
+	if c != nil {
+		MergeInto(errPtr, Catch0(c.Close))
+	}
+}
+
+// CallInto calls f, merging the returned error (or panic) into *errPtr using [MergeInto].
+func CallInto(errPtr *error, f func() error) {
+	if f != nil {
+		MergeInto(errPtr, Catch0(f))
+	}
+}
+
+// FlushInto calls [Flusher.Flush], merging the returned error (or panic) into *errPtr using [MergeInto].
+func FlushInto(errPtr *error, f Flusher) {
+	if f != nil {
+		MergeInto(errPtr, Catch0(f.Flush))
+	}
+}
+
+// OnError calls rollback only if *errPtr is not nil, merging the returned error (or panic) into *errPtr using
+// [MergeInto]. Handy for rolling back transactions, i.e. "defer errorz.OnError(&err, tx.Rollback)".
+func OnError(errPtr *error, rollback func() error) {
+	Assertf(errPtr != nil, "errPtr is nil")
+
+	if *errPtr != nil && rollback != nil {
+		MergeInto(errPtr, Catch0(rollback))
+	}
+}
+
+// MergeInto merges a secondary error into *errPtr. If *errPtr is nil, it is set to the wrapped secondary error. If both
+// are not nil, *errPtr is set to a wrapped error joining the primary and secondary errors, in this order, so that
+// neither is lost and both show up in its [Summary]. If the secondary error is nil, *errPtr is left unchanged.
+func MergeInto(errPtr *error, secondaryErr error) {
+	Assertf(errPtr != nil, "errPtr is nil")
+
+	switch {
+	case secondaryErr == nil:
+		return
+	case *errPtr == nil:
+		*errPtr = Wrap(secondaryErr)
+	default:
+		*errPtr = Wrap(errors.Join(*errPtr, secondaryErr))
+	}
+}
@@ -1,6 +1,7 @@
 package errorz_test
 
 import (
+	"fmt"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -77,3 +78,134 @@ func TestMustClose_Error(t *testing.T) {
 
 	g.Expect(func() { errorz.MustClose(c) }).To(PanicWith(MatchError("test error")))
 }
+
+type flusherTestFunc func() error
+
+func (f flusherTestFunc) Flush() error {
+	return f()
+}
+
+func TestCloseInto(t *testing.T) {
+	g := NewWithT(t)
+	ctrl := gomock.NewController(t)
+	c := tioz.NewMockTestReadCloser(ctrl)
+
+	c.EXPECT().
+		Close().
+		Return(nil).
+		Times(1)
+
+	var err error
+	errorz.CloseInto(&err, c)
+	g.Expect(err).To(Succeed())
+
+	c.EXPECT().
+		Close().
+		Return(fmt.Errorf("close error")).
+		Times(1)
+
+	err = errorz.Errorf("primary error")
+	errorz.CloseInto(&err, c)
+	g.Expect(err).To(MatchError("primary error\nclose error"))
+
+	s := errorz.GetSummary(err, true)
+	g.Expect(s.Components[0].Components[0].Name).To(Equal("[join]"))
+	g.Expect(s.Components[0].Components[0].Components).To(HaveLen(2))
+	g.Expect(s.Components[0].Components[0].Components[0].Name).To(Equal("[wrap]"))
+	g.Expect(s.Components[0].Components[0].Components[0].Components[0].Message).To(Equal("primary error"))
+	g.Expect(s.Components[0].Components[0].Components[1].Components[0].Message).To(Equal("close error"))
+
+	c.EXPECT().
+		Close().
+		DoAndReturn(func() error {
+			panic("close panic")
+		}).
+		Times(1)
+
+	err = nil
+	errorz.CloseInto(&err, c)
+	g.Expect(err).To(MatchError("close panic"))
+
+	errorz.CloseInto(&err, nil)
+	g.Expect(err).To(MatchError("close panic"))
+}
+
+func TestCallInto(t *testing.T) {
+	g := NewWithT(t)
+
+	var err error
+	errorz.CallInto(&err, func() error { return nil })
+	g.Expect(err).To(Succeed())
+
+	errorz.CallInto(&err, func() error { return fmt.Errorf("e1") })
+	g.Expect(err).To(MatchError("e1"))
+	g.Expect(errorz.GetFrames(err)).ToNot(BeEmpty())
+
+	errorz.CallInto(&err, func() error { return fmt.Errorf("e2") })
+	g.Expect(err).To(MatchError("e1\ne2"))
+
+	errorz.CallInto(&err, nil)
+	g.Expect(err).To(MatchError("e1\ne2"))
+}
+
+func TestFlushInto(t *testing.T) {
+	g := NewWithT(t)
+
+	var err error
+	errorz.FlushInto(&err, flusherTestFunc(func() error { return nil }))
+	g.Expect(err).To(Succeed())
+
+	errorz.FlushInto(&err, flusherTestFunc(func() error { return fmt.Errorf("flush error") }))
+	g.Expect(err).To(MatchError("flush error"))
+
+	errorz.FlushInto(&err, nil)
+	g.Expect(err).To(MatchError("flush error"))
+}
+
+func TestOnError(t *testing.T) {
+	g := NewWithT(t)
+	calls := 0
+
+	rollback := func() error {
+		calls++
+		return fmt.Errorf("rollback error")
+	}
+
+	var err error
+	errorz.OnError(&err, rollback)
+	g.Expect(err).To(Succeed())
+	g.Expect(calls).To(Equal(0))
+
+	err = errorz.Errorf("primary error")
+	errorz.OnError(&err, rollback)
+	g.Expect(err).To(MatchError("primary error\nrollback error"))
+	g.Expect(calls).To(Equal(1))
+
+	err = errorz.Errorf("primary error")
+	errorz.OnError(&err, func() error { return nil })
+	g.Expect(err).To(MatchError("primary error"))
+
+	g.Expect(func() { errorz.OnError(nil, rollback) }).To(PanicWith(MatchError("errPtr is nil")))
+}
+
+func TestMergeInto(t *testing.T) {
+	g := NewWithT(t)
+
+	var err error
+	errorz.MergeInto(&err, nil)
+	g.Expect(err).To(Succeed())
+
+	e1 := fmt.Errorf("e1")
+	errorz.MergeInto(&err, e1)
+	g.Expect(err).To(MatchError(e1))
+	g.Expect(errorz.Unwrap(err)).To(HaveExactElements(BeIdenticalTo(e1)))
+
+	errorz.MergeInto(&err, nil)
+	g.Expect(err).To(MatchError("e1"))
+
+	errorz.MergeInto(&err, fmt.Errorf("e2"))
+	g.Expect(err).To(MatchError("e1\ne2"))
+	g.Expect(err).To(MatchError(e1))
+
+	g.Expect(func() { errorz.MergeInto(nil, e1) }).To(PanicWith(MatchError("errPtr is nil")))
+}
@@ -0,0 +1,154 @@
+package errorz
+
+import (
+	"reflect"
+	"slices"
+)
+
+// WalkStep describes an error visited by [Walk].
+type WalkStep struct {
+	// Err is the visited error.
+	Err error
+	// Depth is the depth of the visited error, zero for the root.
+	Depth int
+	// Path contains the indexes of the errors leading to the visited error, as returned by [Unwrap] at each level.
+	Path []int
+}
+
+// WalkFunc is called by [Walk] for each visited error. Returning false stops the walk.
+type WalkFunc func(step *WalkStep) bool
+
+// Walk visits the tree of errors rooted at err depth-first, in pre-order, calling f for each error. Children are found
+// using [Unwrap], so errors implementing either [UnwrapSingle] or [UnwrapMulti] (including wrapped errors and errors
+// created by [WrapRecover]) are traversed. Errors with pointer types are visited at most once, which protects against
+// cycles.
+func Walk(err error, f WalkFunc) {
+	newWalker(f, false).walk(err, 0, nil)
+}
+
+// Find returns the first error of type T found by [Walk]. Unlike [As], it does not consider "As" methods.
+func Find[T any](err error) (T, bool) {
+	var t T
+	var found bool
+
+	Walk(err, func(step *WalkStep) bool {
+		t, found = any(step.Err).(T)
+		return !found
+	})
+
+	return t, found
+}
+
+// FindAll returns all the errors of type T found by [Walk], in order.
+func FindAll[T any](err error) []T {
+	var ts []T
+
+	Walk(err, func(step *WalkStep) bool {
+		if t, ok := any(step.Err).(T); ok {
+			ts = append(ts, t)
+		}
+		return true
+	})
+
+	return ts
+}
+
+// Flatten returns the leaves of the tree of errors rooted at err (i.e. the errors which do not wrap other errors), in
+// the order in which they are found by [Walk].
+func Flatten(err error) []error {
+	var errs []error
+
+	Walk(err, func(step *WalkStep) bool {
+		if len(Unwrap(step.Err)) == 0 {
+			errs = append(errs, step.Err)
+		}
+		return true
+	})
+
+	return errs
+}
+
+// Any returns true if pred returns true for any of the errors found by [Walk].
+func Any(err error, pred func(err error) bool) bool {
+	var found bool
+
+	Walk(err, func(step *WalkStep) bool {
+		found = pred(step.Err)
+		return !found
+	})
+
+	return found
+}
+
+func walkErrors(err error, f func(err error)) {
+	newWalker(func(step *WalkStep) bool {
+		f(step.Err)
+		return true
+	}, true).walk(err, 0, nil)
+}
+
+type walkKey struct {
+	t reflect.Type
+	p uintptr
+}
+
+type walker struct {
+	f         WalkFunc
+	postOrder bool
+	visited   map[walkKey]struct{}
+}
+
+func newWalker(f WalkFunc, postOrder bool) *walker {
+	return &walker{
+		f:         f,
+		postOrder: postOrder,
+		visited:   make(map[walkKey]struct{}),
+	}
+}
+
+func (w *walker) walk(err error, depth int, path []int) bool {
+	if err == nil || !w.markVisited(err) {
+		return true
+	}
+
+	step := &WalkStep{
+		Err:   err,
+		Depth: depth,
+		Path:  path,
+	}
+
+	if !w.postOrder && !w.f(step) {
+		return false
+	}
+
+	for i, uErr := range Unwrap(err) {
+		if !w.walk(uErr, depth+1, append(slices.Clip(path), i)) {
+			return false
+		}
+	}
+
+	if w.postOrder && !w.f(step) {
+		return false
+	}
+
+	return true
+}
+
+func (w *walker) markVisited(err error) bool {
+	v := reflect.ValueOf(err)
+	if v.Kind() != reflect.Ptr {
+		return true
+	}
+
+	k := walkKey{
+		t: v.Type(),
+		p: v.Pointer(),
+	}
+
+	if _, ok := w.visited[k]; ok {
+		return false
+	}
+
+	w.visited[k] = struct{}{}
+	return true
+}
@@ -0,0 +1,136 @@
+package errorz_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/ibrt/golang-utils/errorz"
+)
+
+type walkTestError struct {
+	name string
+	next error
+}
+
+func (e *walkTestError) Error() string {
+	return e.name
+}
+
+func (e *walkTestError) Unwrap() error {
+	return e.next
+}
+
+func newWalkTestTree() (error, []error) {
+	e1 := &walkTestError{name: "e1", next: nil}
+	e2 := fmt.Errorf("e2")
+	e3 := &walkTestError{name: "e3", next: e2}
+	e4 := errors.Join(e1, e3)
+	e5 := errorz.Wrap(e4, fmt.Errorf("e5"))
+	return e5, []error{e5, e4, e1, e3, e2, errorz.Unwrap(e5)[1]}
+}
+
+func TestWalk(t *testing.T) {
+	g := NewWithT(t)
+	err, errs := newWalkTestTree()
+
+	steps := make([]*errorz.WalkStep, 0)
+	errorz.Walk(err, func(step *errorz.WalkStep) bool {
+		steps = append(steps, step)
+		return true
+	})
+
+	g.Expect(steps).To(HaveLen(6))
+
+	for i, step := range steps {
+		g.Expect(step.Err).To(BeIdenticalTo(errs[i]))
+	}
+
+	g.Expect(steps[0].Depth).To(Equal(0))
+	g.Expect(steps[0].Path).To(BeEmpty())
+	g.Expect(steps[3].Depth).To(Equal(2))
+	g.Expect(steps[3].Path).To(Equal([]int{0, 1}))
+	g.Expect(steps[4].Depth).To(Equal(3))
+	g.Expect(steps[4].Path).To(Equal([]int{0, 1, 0}))
+	g.Expect(steps[5].Depth).To(Equal(1))
+	g.Expect(steps[5].Path).To(Equal([]int{1}))
+
+	count := 0
+	errorz.Walk(err, func(_ *errorz.WalkStep) bool {
+		count++
+		return count < 3
+	})
+	g.Expect(count).To(Equal(3))
+
+	errorz.Walk(nil, func(_ *errorz.WalkStep) bool {
+		panic("unexpected")
+	})
+}
+
+func TestWalk_Cycle(t *testing.T) {
+	g := NewWithT(t)
+
+	e1 := &walkTestError{name: "e1", next: nil}
+	e2 := &walkTestError{name: "e2", next: e1}
+	e1.next = e2
+
+	g.Expect(errorz.Flatten(e1)).To(BeEmpty())
+	g.Expect(errorz.FindAll[*walkTestError](errorz.Wrap(e1))).To(HaveExactElements(e1, e2))
+}
+
+func TestWalk_Recover(t *testing.T) {
+	g := NewWithT(t)
+
+	e := fmt.Errorf("e")
+	err := errorz.WrapRecover(e)
+	g.Expect(errorz.Flatten(err)).To(HaveExactElements(BeIdenticalTo(e)))
+
+	err = errorz.WrapRecover("v")
+	g.Expect(errorz.Flatten(err)).To(HaveExactElements(MatchError("v")))
+}
+
+func TestFind(t *testing.T) {
+	g := NewWithT(t)
+	err, errs := newWalkTestTree()
+
+	e, ok := errorz.Find[*walkTestError](err)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(e).To(BeIdenticalTo(errs[2]))
+
+	_, ok = errorz.Find[*kindTestError](err)
+	g.Expect(ok).To(BeFalse())
+
+	_, ok = errorz.Find[*walkTestError](nil)
+	g.Expect(ok).To(BeFalse())
+}
+
+func TestFindAll(t *testing.T) {
+	g := NewWithT(t)
+	err, errs := newWalkTestTree()
+
+	g.Expect(errorz.FindAll[*walkTestError](err)).To(HaveExactElements(errs[2], errs[3]))
+	g.Expect(errorz.FindAll[errorz.UnwrapMulti](err)).To(HaveLen(2))
+	g.Expect(errorz.FindAll[*kindTestError](err)).To(BeEmpty())
+}
+
+func TestFlatten(t *testing.T) {
+	g := NewWithT(t)
+	err, errs := newWalkTestTree()
+
+	g.Expect(errorz.Flatten(err)).To(HaveExactElements(errs[2], errs[4], errs[5]))
+	g.Expect(errorz.Flatten(errs[4])).To(HaveExactElements(errs[4]))
+	g.Expect(errorz.Flatten(nil)).To(BeEmpty())
+}
+
+func TestAny(t *testing.T) {
+	g := NewWithT(t)
+	err, _ := newWalkTestTree()
+
+	g.Expect(errorz.Any(err, func(err error) bool { return err.Error() == "e2" })).To(BeTrue())
+	g.Expect(errorz.Any(err, func(err error) bool { return err.Error() == "e6" })).To(BeFalse())
+	g.Expect(errorz.Any(errorz.NotFoundf("e"), func(err error) bool {
+		return errorz.GetSummary(err, false).HTTPStatus != 0
+	})).To(BeTrue())
+}
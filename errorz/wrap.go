@@ -3,27 +3,43 @@ package errorz
 import (
 	"errors"
 	"reflect"
+	"slices"
 	"sync"
 )
 
 // Wrap wraps the given errors.
 func Wrap(err error, outerErrs ...error) error {
+	return wrap(err, true, outerErrs)
+}
+
+func wrap(err error, runHooks bool, outerErrs []error) error {
 	if err == nil {
 		MustErrorf("err is nil")
 	}
 
-	wErr, ok := err.(*wrappedError) //nolint:errorlint
-	if !ok {
+	wErr, isWrapped := err.(*wrappedError) //nolint:errorlint
+	if !isWrapped {
+		var callers []uintptr
+
+		if shouldCaptureFrames(err, outerErrs) {
+			callers = captureCallers()
+		}
+
 		wErr = &wrappedError{
-			m:        &sync.Mutex{},
-			errs:     []error{err},
-			frames:   GetFrames(nil),
-			metadata: make(map[any]any),
+			m:            &sync.Mutex{},
+			errs:         []error{err},
+			callers:      callers,
+			frames:       nil,
+			trailCallers: nil,
+			trail:        nil,
+			metadata:     make(map[any]any),
 		}
+	} else if slices.ContainsFunc(outerErrs, func(outerErr error) bool { return outerErr != nil }) &&
+		shouldCaptureFrames(err, outerErrs) {
+		wErr.addWrapSite(captureWrapSite())
 	}
 
 	wErr.m.Lock()
-	defer wErr.m.Unlock()
 
 	for _, outerErr := range outerErrs {
 		if outerErr != nil {
@@ -31,9 +47,43 @@ func Wrap(err error, outerErrs ...error) error {
 		}
 	}
 
+	wErr.m.Unlock()
+
+	if !isWrapped && runHooks {
+		maybeRunHooks(HookTypeWrap, wErr)
+	}
+
 	return wErr
 }
 
+// Annotate is like [MaybeWrap] without outer errors, but it also records the call site in the wrap trail of the error
+// (see [GetWrapTrail]). It can be used to keep track of the path taken by an error across layers, without adding
+// context to it.
+func Annotate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	wErr := Wrap(err).(*wrappedError) //nolint:errorlint,forcetypeassert
+
+	if shouldCaptureFrames(wErr, nil) {
+		wErr.addWrapSite(captureWrapSite())
+	}
+
+	return wErr
+}
+
+// GetWrapTrail returns the wrap trail of the error if it has been wrapped, nil otherwise. The wrap trail contains one
+// frame for each call to [Wrap] (and related functions) that added outer errors to an already wrapped error, and for
+// each call to [Annotate], in call order.
+func GetWrapTrail(err error) Frames {
+	if e, ok := err.(*wrappedError); ok { //nolint:errorlint
+		return e.getWrapTrail()
+	}
+
+	return nil
+}
+
 // MaybeWrap is like [Wrap], but returns nil if called with a nil error.
 func MaybeWrap(err error, outerErrs ...error) error {
 	if err != nil {
@@ -55,7 +105,8 @@ func MaybeMustWrap(err error, outerErrs ...error) {
 	}
 }
 
-// WrapRecover takes a recovered value and converts it to a wrapped error.
+// WrapRecover takes a recovered value and converts it to a wrapped error. It runs hooks with [HookTypeRecover] only,
+// i.e. not also with [HookTypeWrap].
 func WrapRecover(r any, outerErrs ...error) error {
 	if isNil(r) {
 		MustErrorf("r is nil")
@@ -68,7 +119,9 @@ func WrapRecover(r any, outerErrs ...error) error {
 		}
 	}
 
-	return Wrap(err, outerErrs...)
+	wErr := wrap(err, false, outerErrs)
+	maybeRunHooks(HookTypeRecover, wErr)
+	return wErr
 }
 
 // MaybeWrapRecover is like [WrapRecover] but returns nil if called with a nil value.
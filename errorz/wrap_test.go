@@ -1,6 +1,7 @@
 package errorz_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"testing"
@@ -244,3 +245,89 @@ func TestUnwrap(t *testing.T) {
 	g.Expect(errorz.Unwrap(fmt.Errorf("e2: %w", fmt.Errorf("e1")))).
 		To(HaveExactElements(fmt.Errorf("e1")))
 }
+
+// Before lazy symbolization, wrapping an error symbolized its frames eagerly (on the reference machine: ~12µs/op,
+// 10912 B/op, 66 allocs/op). Capturing program counters only brings it to ~1µs/op, 264 B/op, 5 allocs/op.
+func BenchmarkWrap(b *testing.B) {
+	e := fmt.Errorf("e")
+	b.ReportAllocs()
+
+	for range b.N {
+		_ = errorz.Wrap(e)
+	}
+}
+
+// Symbolizing on demand costs about the same as before (on the reference machine: ~16µs/op, 10960 B/op, 67 allocs/op
+// eagerly, vs ~10µs/op, 2496 B/op, 58 allocs/op lazily), so errors whose frames are never read save most of it.
+func BenchmarkWrap_GetFrames(b *testing.B) {
+	e := fmt.Errorf("e")
+	b.ReportAllocs()
+
+	for range b.N {
+		_ = errorz.GetFrames(errorz.Wrap(e))
+	}
+}
+
+func BenchmarkWrap_FramesCaptureDisabled(b *testing.B) {
+	k := errorz.MustRegisterKind("bench-kind-frames-capture", 400, errorz.GRPCCodeUnknown)
+	defer errorz.UnregisterKind("bench-kind-frames-capture")
+	defer k.SetFramesCapture(true)
+	e := &kindTestError{kind: k.SetFramesCapture(false)}
+	b.ReportAllocs()
+
+	for range b.N {
+		_ = errorz.Wrap(e)
+	}
+}
+
+func wrapTrailTestHelper(err error) error {
+	return errorz.Wrap(err, fmt.Errorf("helper"))
+}
+
+func TestGetWrapTrail(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.GetWrapTrail(nil)).To(BeNil())
+	g.Expect(errorz.GetWrapTrail(fmt.Errorf("e"))).To(BeNil())
+
+	err := errorz.Wrap(fmt.Errorf("e"), fmt.Errorf("o1"))
+	g.Expect(errorz.GetWrapTrail(err)).To(BeEmpty())
+
+	g.Expect(errorz.Wrap(err)).To(BeIdenticalTo(err))
+	g.Expect(errorz.Wrap(err, nil)).To(BeIdenticalTo(err))
+	g.Expect(errorz.GetWrapTrail(err)).To(BeEmpty())
+
+	g.Expect(wrapTrailTestHelper(err)).To(BeIdenticalTo(err))
+	g.Expect(errorz.Annotate(err)).To(BeIdenticalTo(err))
+	g.Expect(errorz.MaybeWrap(err, fmt.Errorf("o2"))).To(BeIdenticalTo(err))
+
+	trail := errorz.GetWrapTrail(err)
+	g.Expect(trail).To(HaveLen(3))
+	g.Expect(trail[0].ShortLocation).To(Equal("errorz_test.wrapTrailTestHelper"))
+	g.Expect(trail[1].ShortLocation).To(Equal("errorz_test.TestGetWrapTrail"))
+	g.Expect(trail[2].ShortLocation).To(Equal("errorz_test.TestGetWrapTrail"))
+	g.Expect(trail[1].Line).To(BeNumerically("<", trail[2].Line))
+
+	s := errorz.GetSummary(err, true)
+	g.Expect(s.WrapTrail).To(Equal(trail.ToSummaries()))
+	g.Expect(s.Components[0].WrapTrail).To(Equal(trail.ToSummaries()))
+
+	s = errorz.GetSummaryWithOptions(err, true, &errorz.SummaryOptions{Audience: errorz.SummaryAudiencePublic})
+	g.Expect(s.WrapTrail).To(BeNil())
+	g.Expect(s.Components[0].WrapTrail).To(BeNil())
+	g.Expect(json.Marshal(s)).ToNot(ContainSubstring("wrapTrail"))
+	g.Expect(errorz.SDump(err)).To(ContainSubstring("errorz_test.wrapTrailTestHelper"))
+	g.Expect(fmt.Sprintf("%+v", err)).To(ContainSubstring("wrap trail:"))
+}
+
+func TestAnnotate(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(errorz.Annotate(nil)).To(BeNil())
+
+	err := errorz.Annotate(fmt.Errorf("e"))
+	g.Expect(err).To(MatchError("e"))
+	g.Expect(errorz.GetFrames(err)[0].ShortLocation).To(Equal("errorz_test.TestAnnotate"))
+	g.Expect(errorz.GetWrapTrail(err)).To(HaveLen(1))
+	g.Expect(errorz.GetWrapTrail(err)[0].ShortLocation).To(Equal("errorz_test.TestAnnotate"))
+}
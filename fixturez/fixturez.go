@@ -2,6 +2,7 @@
 package fixturez
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -190,9 +191,13 @@ func (rs *runnableSuite) registerCustomFormatters() {
 		format.RegisterCustomFormatter(func(v any) (string, bool) {
 			switch err := v.(type) {
 			case error:
-				buf, err := json.MarshalIndent(errorz.GetSummary(err, true), "", "  ")
-				errorz.MaybeMustWrap(err)
-				return format.Indent + string(buf), true
+				// HTML escaping is disabled to keep source code snippets (in development mode) readable.
+				buf := &bytes.Buffer{}
+				enc := json.NewEncoder(buf)
+				enc.SetEscapeHTML(false)
+				enc.SetIndent("", "  ")
+				errorz.MaybeMustWrap(enc.Encode(errorz.GetSummary(err, true)))
+				return format.Indent + strings.TrimSuffix(buf.String(), "\n"), true
 			default:
 				return "", false
 			}
@@ -87,14 +87,36 @@ func (*SuiteCorrect) TestErrorFormatter(g *WithT) {
 		To(Equal("<string>: \"test string\""))
 }
 
+func (*SuiteCorrect) TestErrorFormatter_DevMode(g *WithT) {
+	errorz.DefaultDevModeOptions = &errorz.DevModeOptions{
+		Enabled:      true,
+		SnippetLines: 0,
+		MaxSnippets:  1,
+	}
+	defer errorz.RestoreDefaultDevModeOptions()
+
+	err := errorz.Errorf("test error")
+	out := format.Object(err, 0)
+	g.Expect(out).To(ContainSubstring("\"message\": \"test error\""))
+
+	if _, ok := errorz.GetSnippet(errorz.GetFrames(err)[0], 0); !ok {
+		// Source files are not available, e.g. with "-trimpath".
+		g.Expect(out).ToNot(ContainSubstring("\"snippets\""))
+		return
+	}
+
+	g.Expect(out).To(ContainSubstring("\"snippets\""))
+	g.Expect(out).To(MatchRegexp(`"> \d+ \| \\terr := errorz\.Errorf\(\\"test error\\"\)"`))
+}
+
 func TestSuite_Correct(t *testing.T) {
 	s := &SuiteCorrect{}
 	fixturez.RunSuite(t, s)
 
 	g := NewWithT(t)
 	g.Expect(s.Helper.beforeSuite).To(Equal(1))
-	g.Expect(s.Helper.beforeTest).To(Equal(3))
-	g.Expect(s.Helper.afterTest).To(Equal(3))
+	g.Expect(s.Helper.beforeTest).To(Equal(4))
+	g.Expect(s.Helper.afterTest).To(Equal(4))
 	g.Expect(s.Helper.afterSuite).To(Equal(1))
 }
 
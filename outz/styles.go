@@ -3,6 +3,8 @@ package outz
 import (
 	"github.com/fatih/color"
 	"github.com/sirupsen/logrus"
+
+	"github.com/ibrt/golang-utils/errorz"
 )
 
 // Styles describes a set of output styles.
@@ -28,7 +30,8 @@ type Styles interface {
 }
 
 var (
-	_ Styles = (*stylesImpl)(nil)
+	_ Styles             = (*stylesImpl)(nil)
+	_ errorz.PrintStyles = Styles(nil)
 )
 
 type stylesImpl struct {
@@ -1,11 +1,13 @@
 package outz_test
 
 import (
+	"bytes"
 	"testing"
 
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 
+	"github.com/ibrt/golang-utils/errorz"
 	"github.com/ibrt/golang-utils/fixturez"
 	"github.com/ibrt/golang-utils/outz"
 )
@@ -43,3 +45,9 @@ func (*StylesSuite) TestRestoreDefaultStyles(g *WithT) {
 	outz.RestoreDefaultStyles()
 	g.Expect(outz.DefaultStyles).To(Equal(defaultStyles))
 }
+
+func (*StylesSuite) TestStyles_ErrorzPrint(g *WithT) {
+	buf := &bytes.Buffer{}
+	g.Expect(errorz.Fprint(buf, errorz.Errorf("e"), &errorz.PrintOptions{Styles: outz.DefaultStyles})).To(Succeed())
+	g.Expect(buf.String()).To(HavePrefix("┌─ e\n"))
+}